@@ -28,7 +28,7 @@ func main() {
 	}
 	log.Printf("Created song: ID=%d, Title=%s, Duration=%d seconds", resp.Id, resp.Title, resp.Duration)
 
-	respList, errList := client.ListSongs(context.Background(), &pb.EmptyMessage{})
+	respList, errList := client.ListSongs(context.Background(), &pb.ListSongsRequest{PageSize: 20})
 	if errList != nil {
 		log.Fatalf("ListSongs call failed: %v", errList)
 	}
@@ -57,7 +57,7 @@ func main() {
 		log.Fatalf("Must be error: The song with this title already exists in the database")
 	}
 
-	respPlay, errPlay := client.Play(context.Background(), &pb.EmptyMessage{})
+	respPlay, errPlay := client.Play(context.Background(), &pb.PlaybackRequest{})
 	if errPlay != nil {
 		log.Fatalf("Play call failed: %v", errList)
 	}
@@ -76,4 +76,16 @@ func main() {
 	if errDel != nil {
 		log.Fatalf("DeleteSong call failed: %v", err)
 	}
+
+	_, errStop := client.Stop(context.Background(), &pb.PlaybackRequest{})
+	if errStop != nil {
+		log.Fatalf("Stop call failed: %v", errStop)
+	}
+
+	_, errDel = client.DeleteSong(context.Background(), &pb.DeleteSongRequest{
+		Title: "Test Song 1",
+	})
+	if errDel != nil {
+		log.Fatalf("DeleteSong call failed: %v", errDel)
+	}
 }
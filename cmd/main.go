@@ -1,11 +1,19 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net"
+	"os"
+	"time"
 
+	"MusicPlayerProject/internal/config"
 	db_song "MusicPlayerProject/internal/db"
 	"MusicPlayerProject/internal/grpcserver"
+	"MusicPlayerProject/internal/migrate"
 	"MusicPlayerProject/internal/usecase"
 	pb "MusicPlayerProject/proto"
 	"database/sql"
@@ -16,39 +24,151 @@ import (
 )
 
 func main() {
-	db, err := sql.Open("postgres", "postgres://user:password@db:5432/playlist?sslmode=disable")
+	cfg, args, err := config.Load(os.Args[1:], os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
-	} else {
-		log.Println("Successfully connected to the database")
+		fatal("Failed to load the configuration", "error", err)
+	}
+
+	command := ""
+	if len(args) > 0 {
+		command = args[0]
+	}
+	if command != "" && command != "migrate" {
+		fatal("Unknown command, expected migrate", "command", command)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		fatal("Invalid configuration", "error", err)
 	}
-	defer db.Close()
+	mode, err := migrate.ParseMode(cfg.Migrate)
+	if err != nil {
+		fatal("Invalid configuration", "error", err)
+	}
+
+	level, _ := cfg.Level()
+	slog.SetLogLoggerLevel(level)
+	slog.Info("Configuration:\n" + cfg.String())
+
+	ctx := context.Background()
 
-	if err := db.Ping(); err != nil {
-		log.Fatalf("Failed to ping database: %v", err)
+	var repo db_song.SongDB
+	if cfg.Storage == "memory" {
+		if command == "migrate" {
+			fatal("Failed to migrate the database", "error", migrate.ErrorUnknownStorage)
+		}
+
+		slog.Info("The songs are kept in memory and lost on exit")
+		repo = db_song.NewMemorySongDB()
 	} else {
-		log.Println("Successfully ping to the database")
+		db, err := openDB(cfg)
+		if err != nil {
+			fatal("Failed to connect to database", "error", err)
+		} else {
+			slog.Info("Successfully connected to the database", "storage", cfg.Storage)
+		}
+		defer db.Close()
+
+		if err := ping(ctx, db, cfg.ConnectTimeout); err != nil {
+			fatal("Failed to ping database", "error", err)
+		} else {
+			slog.Info("Successfully ping to the database")
+		}
+
+		provider, err := migrate.NewProvider(cfg.Storage, db)
+		if err != nil {
+			fatal("Failed to load the migrations", "error", err)
+		}
+
+		if command == "migrate" {
+			subcommand := ""
+			if len(args) > 1 {
+				subcommand = args[1]
+			}
+			if err := migrate.Command(ctx, provider, subcommand); err != nil {
+				fatal("Failed to migrate the database", "error", err)
+			}
+			return
+		}
+
+		if err := migrate.Run(ctx, provider, mode); err != nil {
+			fatal("Failed to migrate the database", "error", err)
+		} else if mode != migrate.ModeOff {
+			slog.Info("The database schema is up to date")
+		}
+
+		if cfg.Storage == "sqlite" {
+			repo = db_song.NewSQLiteSongDB(db)
+		} else {
+			repo = db_song.NewSongDB(db)
+		}
 	}
 
-	repo := db_song.NewSongDB(db)
-	controller := usecase.NewPlaylistController(repo)
+	controller, err := usecase.NewPlaylistController(ctx, repo)
+	if err != nil {
+		fatal("Failed to load the playlist", "error", err)
+	} else {
+		slog.Info("Successfully loaded the playlist")
+	}
 	grpcServerInstance := grpcserver.NewGRPCServer(controller)
 
-	listener, err := net.Listen("tcp", ":8080")
+	listener, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
-		log.Fatalf("Failed to listen on port 8080: %v", err)
+		fatal("Failed to listen", "address", cfg.ListenAddr, "error", err)
 	} else {
-		log.Println("Successfully listen on port 8080")
+		slog.Info("Successfully listen", "address", cfg.ListenAddr)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcserver.UnaryTimeoutInterceptor(cfg.RequestTimeout),
+			grpcserver.UnaryErrorInterceptor,
+		),
+		grpc.StreamInterceptor(grpcserver.StreamErrorInterceptor),
+	)
 
 	pb.RegisterPlaylistServiceServer(grpcServer, grpcServerInstance)
 
 	reflection.Register(grpcServer)
 
-	log.Println("gRPC server is running on port 8080...")
+	slog.Info("gRPC server is running", "address", cfg.ListenAddr)
 	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatalf("Failed to serve gRPC server: %v", err)
+		fatal("Failed to serve gRPC server", "error", err)
+	}
+}
+
+// fatal logs the error and exits, whatever the log level is.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// openDB opens the database of the storage.
+func openDB(cfg *config.Config) (*sql.DB, error) {
+	switch cfg.Storage {
+	case "postgres":
+		db, err := sql.Open("postgres", cfg.DSN)
+		if err != nil {
+			return nil, err
+		}
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		return db, nil
+	case "sqlite":
+		return db_song.OpenSQLite(cfg.DSN)
+	default:
+		return nil, fmt.Errorf("unknown storage %q", cfg.Storage)
+	}
+}
+
+func ping(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
+	return db.PingContext(ctx)
 }
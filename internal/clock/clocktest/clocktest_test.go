@@ -0,0 +1,54 @@
+package clocktest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvance(t *testing.T) {
+	start := time.Date(2024, 12, 19, 0, 0, 0, 0, time.UTC)
+	clock := NewClock(start)
+
+	short := clock.NewTimer(time.Second)
+	long := clock.After(3 * time.Second)
+
+	clock.Advance(2 * time.Second)
+	assert.Equal(t, start.Add(2*time.Second), clock.Now(), "expected time to move by 2s")
+
+	select {
+	case fired := <-short.C():
+		assert.Equal(t, start.Add(2*time.Second), fired, "expected the timer to fire at the current time")
+	default:
+		t.Fatal("expected the short timer to fire")
+	}
+
+	select {
+	case <-long:
+		t.Fatal("expected the long timer not to fire yet")
+	default:
+	}
+
+	clock.Advance(time.Second)
+	_, ok := <-long
+	assert.True(t, ok, "expected the long timer to fire")
+}
+
+func TestStop(t *testing.T) {
+	clock := NewClock(time.Now())
+
+	timer := clock.NewTimer(time.Second)
+	clock.BlockUntil(1)
+
+	assert.True(t, timer.Stop(), "expected the active timer to be stopped")
+	assert.False(t, timer.Stop(), "expected the stopped timer not to be stopped again")
+	clock.BlockUntil(0)
+
+	clock.Advance(time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("expected the stopped timer not to fire")
+	default:
+	}
+}
@@ -0,0 +1,263 @@
+// Package config loads the configuration of the server. Every setting comes,
+// from the lowest priority to the highest, from its default, the optional
+// config file, the environment and the command line flags.
+package config
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// envPrefix prefixes the environment variables of the settings, the
+// variable of the flag -listen-addr is PLAYLIST_LISTEN_ADDR.
+const envPrefix = "PLAYLIST_"
+
+// defaultDSNs are the data sources of the storages when the DSN is not set.
+var defaultDSNs = map[string]string{
+	"postgres": "postgres://user:password@db:5432/playlist?sslmode=disable",
+	"sqlite":   "playlist.db",
+}
+
+type Config struct {
+	ListenAddr string
+	Storage    string
+	DSN        string
+	// Migrate is the migrate.Mode at startup, the server checks it as it
+	// runs the migrations.
+	Migrate string
+
+	// The pool of the PostgreSQL connections, SQLite uses one connection.
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+
+	// ConnectTimeout limits the ping of the database at startup.
+	ConnectTimeout time.Duration
+	// RequestTimeout limits every unary call, zero leaves them unlimited.
+	RequestTimeout time.Duration
+
+	LogLevel string
+}
+
+// Load returns the configuration of the arguments, without the program
+// name, the environment and the config file given by -config or
+// PLAYLIST_CONFIG. The arguments left after the flags are returned too.
+func Load(args []string, lookupEnv func(string) (string, bool)) (*Config, []string, error) {
+	c := &Config{}
+	fs := flag.NewFlagSet("playlist", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s [flags] [migrate up|down|status|version]\n\n", os.Args[0])
+		fmt.Fprintf(fs.Output(), "Every flag may be set by the environment variable %s<FLAG> too, -listen-addr by %sLISTEN_ADDR.\n\n", envPrefix, envPrefix)
+		fs.PrintDefaults()
+	}
+
+	configFile := fs.String("config", "", "optional file of the settings, one NAME=value per line with the names of the environment variables")
+	fs.StringVar(&c.ListenAddr, "listen-addr", ":8080", "address the gRPC server listens on")
+	fs.StringVar(&c.Storage, "storage", "postgres", "storage of the songs: postgres, sqlite or memory")
+	fs.StringVar(&c.DSN, "dsn", "", "data source of the storage, the default of the storage when empty")
+	fs.StringVar(&c.Migrate, "migrate", "up", "migrations at startup: up applies the pending ones, check refuses to start while one is pending, off skips them")
+	fs.IntVar(&c.MaxOpenConns, "db-max-open-conns", 10, "most open connections to PostgreSQL, 0 is unlimited")
+	fs.IntVar(&c.MaxIdleConns, "db-max-idle-conns", 5, "most idle connections to PostgreSQL")
+	fs.DurationVar(&c.ConnMaxLifetime, "db-conn-max-lifetime", 30*time.Minute, "longest use of a connection to PostgreSQL, 0 is unlimited")
+	fs.DurationVar(&c.ConnectTimeout, "db-connect-timeout", 10*time.Second, "timeout of the first ping of the database")
+	fs.DurationVar(&c.RequestTimeout, "request-timeout", 30*time.Second, "timeout of a unary call, 0 is unlimited")
+	fs.StringVar(&c.LogLevel, "log-level", "info", "least level of the logs: debug, info, warn or error")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the flags set on the command line win over the file and the environment
+	explicit := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	path, ok := lookupEnv(envPrefix + "CONFIG")
+	if explicit["config"] || !ok {
+		path = *configFile
+	}
+
+	var fileValues map[string]string
+	if path != "" {
+		fileValues, err = readFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var errs []error
+	fs.VisitAll(func(f *flag.Flag) {
+		name := envName(f.Name)
+		value, ok := fileValues[name]
+		delete(fileValues, name)
+		if explicit[f.Name] || f.Name == "config" {
+			return
+		}
+
+		if envValue, envOk := lookupEnv(name); envOk {
+			value, ok = envValue, true
+		}
+		if !ok {
+			return
+		}
+
+		err := fs.Set(f.Name, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s: %w", name, err))
+		}
+	})
+	for _, name := range slices.Sorted(maps.Keys(fileValues)) {
+		errs = append(errs, fmt.Errorf("unknown setting %s in %s", name, path))
+	}
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	if c.DSN == "" {
+		c.DSN = defaultDSNs[c.Storage]
+	}
+	return c, fs.Args(), nil
+}
+
+// envName returns the environment variable of the flag.
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// readFile reads the NAME=value lines of the config file. The empty lines
+// and the lines starting with # are skipped.
+func readFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseFile(f, path)
+}
+
+func parseFile(r io.Reader, path string) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		name, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected NAME=value", path, line)
+		}
+		values[strings.TrimSpace(name)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	return values, scanner.Err()
+}
+
+// Validate returns the errors of all the invalid settings together.
+func (c *Config) Validate() error {
+	var errs []error
+
+	_, _, err := net.SplitHostPort(c.ListenAddr)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid listen address %q: %w", c.ListenAddr, err))
+	}
+
+	switch c.Storage {
+	case "postgres", "sqlite":
+		if c.DSN == "" {
+			errs = append(errs, fmt.Errorf("the %s storage needs a DSN", c.Storage))
+		}
+	case "memory":
+	default:
+		errs = append(errs, fmt.Errorf("unknown storage %q, expected postgres, sqlite or memory", c.Storage))
+	}
+
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("the connection pool sizes cannot be negative"))
+	}
+	if c.MaxOpenConns > 0 && c.MaxIdleConns > c.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("%d idle connections exceed %d open ones", c.MaxIdleConns, c.MaxOpenConns))
+	}
+
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"connection lifetime", c.ConnMaxLifetime},
+		{"connect timeout", c.ConnectTimeout},
+		{"request timeout", c.RequestTimeout},
+	}
+	for _, d := range durations {
+		if d.value < 0 {
+			errs = append(errs, fmt.Errorf("the %s cannot be negative", d.name))
+		}
+	}
+
+	_, err = c.Level()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// Level returns the slog level of LogLevel.
+func (c *Config) Level() (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(c.LogLevel))
+	if err != nil {
+		return 0, fmt.Errorf("invalid log level %q, expected debug, info, warn or error", c.LogLevel)
+	}
+	return level, nil
+}
+
+// String returns the settings one per line with the password of the DSN
+// redacted, so the effective configuration can be logged.
+func (c *Config) String() string {
+	settings := []struct {
+		name  string
+		value any
+	}{
+		{"listen-addr", c.ListenAddr},
+		{"storage", c.Storage},
+		{"dsn", RedactDSN(c.DSN)},
+		{"migrate", c.Migrate},
+		{"db-max-open-conns", c.MaxOpenConns},
+		{"db-max-idle-conns", c.MaxIdleConns},
+		{"db-conn-max-lifetime", c.ConnMaxLifetime},
+		{"db-connect-timeout", c.ConnectTimeout},
+		{"request-timeout", c.RequestTimeout},
+		{"log-level", c.LogLevel},
+	}
+
+	var b strings.Builder
+	for _, setting := range settings {
+		fmt.Fprintf(&b, "%s=%v\n", setting.name, setting.value)
+	}
+	return b.String()
+}
+
+// passwordParam matches the password of a key=value DSN.
+var passwordParam = regexp.MustCompile(`(?i)(password=)('[^']*'|\S*)`)
+
+// RedactDSN hides the password of a URL or a key=value DSN.
+func RedactDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err == nil && u.User != nil {
+		dsn = u.Redacted()
+	}
+	return passwordParam.ReplaceAllString(dsn, "${1}xxxxx")
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// env returns the lookup of the environment variables.
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	c, args, err := Load(nil, env(nil))
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Empty(t, args, "expected no arguments")
+
+	assert.Equal(t, ":8080", c.ListenAddr, "expected the default address")
+	assert.Equal(t, "postgres", c.Storage, "expected the default storage")
+	assert.Equal(t, defaultDSNs["postgres"], c.DSN, "expected the default DSN of the storage")
+	assert.Equal(t, 30*time.Second, c.RequestTimeout, "expected the default request timeout")
+	assert.NoError(t, c.Validate(), "expected the defaults to be valid")
+
+	c, _, err = Load([]string{"-storage=sqlite"}, env(nil))
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, "playlist.db", c.DSN, "expected the default DSN of SQLite")
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "playlist.env")
+	file := `
+# the file sets the lowest priority values
+PLAYLIST_LISTEN_ADDR=:7070
+PLAYLIST_STORAGE=sqlite
+PLAYLIST_DSN="file.db"
+PLAYLIST_LOG_LEVEL=debug
+`
+	assert.NoError(t, os.WriteFile(path, []byte(file), 0o600))
+
+	c, args, err := Load(
+		[]string{"-config", path, "-dsn=flag.db", "-db-max-open-conns=20", "migrate", "status"},
+		env(map[string]string{
+			"PLAYLIST_STORAGE":         "memory",
+			"PLAYLIST_DSN":             "env.db",
+			"PLAYLIST_REQUEST_TIMEOUT": "5s",
+		}),
+	)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, []string{"migrate", "status"}, args, "expected the arguments after the flags")
+
+	assert.Equal(t, ":7070", c.ListenAddr, "expected the value of the file")
+	assert.Equal(t, "debug", c.LogLevel, "expected the value of the file")
+	assert.Equal(t, "memory", c.Storage, "expected the environment to override the file")
+	assert.Equal(t, 5*time.Second, c.RequestTimeout, "expected the environment to override the default")
+	assert.Equal(t, "flag.db", c.DSN, "expected the flag to override the environment")
+	assert.Equal(t, 20, c.MaxOpenConns, "expected the flag to override the default")
+
+	// the file is found by the environment too
+	c, _, err = Load(nil, env(map[string]string{"PLAYLIST_CONFIG": path}))
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, "file.db", c.DSN, "expected the value of the file")
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, _, err := Load(nil, env(map[string]string{"PLAYLIST_REQUEST_TIMEOUT": "soon"}))
+	assert.ErrorContains(t, err, "PLAYLIST_REQUEST_TIMEOUT", "expected the invalid variable in the error")
+
+	path := filepath.Join(t.TempDir(), "playlist.env")
+	assert.NoError(t, os.WriteFile(path, []byte("PLAYLIST_LISTEN=:80\n"), 0o600))
+	_, _, err = Load([]string{"-config", path}, env(nil))
+	assert.ErrorContains(t, err, "unknown setting PLAYLIST_LISTEN", "expected the unknown setting in the error")
+
+	assert.NoError(t, os.WriteFile(path, []byte("listen\n"), 0o600))
+	_, _, err = Load([]string{"-config", path}, env(nil))
+	assert.ErrorContains(t, err, ":1: expected NAME=value", "expected the line of the error")
+
+	_, _, err = Load([]string{"-config", filepath.Join(t.TempDir(), "missing.env")}, env(nil))
+	assert.Error(t, err, "expected an error for a missing file")
+}
+
+func TestValidate(t *testing.T) {
+	c, _, err := Load(nil, env(nil))
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	c.ListenAddr = "8080"
+	c.Storage = "mysql"
+	c.MaxOpenConns = 2
+	c.MaxIdleConns = 3
+	c.RequestTimeout = -time.Second
+	c.LogLevel = "loud"
+
+	// every invalid setting is reported at once
+	err = c.Validate()
+	assert.Error(t, err, "expected an error for the invalid settings")
+	for _, want := range []string{"listen address", "unknown storage", "idle connections", "request timeout", "log level"} {
+		assert.ErrorContains(t, err, want, "expected the error of the %s", want)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, "postgres://user:xxxxx@db:5432/playlist?sslmode=disable", RedactDSN(defaultDSNs["postgres"]))
+	assert.Equal(t, "host=db user=user password=xxxxx dbname=playlist", RedactDSN("host=db user=user password=secret dbname=playlist"))
+	assert.Equal(t, "playlist.db", RedactDSN("playlist.db"))
+
+	c, _, err := Load(nil, env(map[string]string{"PLAYLIST_DSN": "postgres://user:secret@db/playlist"}))
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.NotContains(t, c.String(), "secret", "expected the password to be redacted")
+	assert.True(t, strings.HasPrefix(c.String(), "listen-addr=:8080\n"), "expected a setting per line")
+}
@@ -2,8 +2,61 @@ package data
 
 import "time"
 
+// Song is a song of the library. A song is identified by its artist, title
+// and album, the other metadata is optional. Tags are free-form key-value pairs.
+// CreatedAt is set by the database when the song is added to the library.
 type Song struct {
-	ID       int
-	Title    string
-	Duration time.Duration
+	ID          int
+	Title       string
+	Duration    time.Duration
+	Artist      string
+	Album       string
+	AlbumArtist string
+	TrackNumber int
+	DiscNumber  int
+	Genre       string
+	Year        int
+	Tags        map[string]string
+	CreatedAt   time.Time
+}
+
+// SongOrder is the field a page of the library is sorted by. Songs with
+// equal values are sorted by their IDs.
+type SongOrder int
+
+const (
+	SongOrderID SongOrder = iota
+	SongOrderTitle
+	SongOrderDuration
+	SongOrderCreatedAt
+)
+
+// SongListOptions selects a page of the library. Only the songs after the
+// After song in the chosen order are listed, zero filters are not applied.
+type SongListOptions struct {
+	Limit       int
+	OrderBy     SongOrder
+	Descending  bool
+	After       *Song
+	MinDuration time.Duration
+	MaxDuration time.Duration
+	TitlePrefix string
+}
+
+// PlayerState is the playback cursor and the modes of the player kept
+// between restarts of the service. SongID is zero when there is no current song.
+type PlayerState struct {
+	SongID      int
+	Position    time.Duration
+	RepeatMode  int
+	Shuffle     bool
+	ShuffleSeed int64
+}
+
+// Playlist is a named list of songs from the library. Songs are in the
+// playback order.
+type Playlist struct {
+	ID    int
+	Name  string
+	Songs []*Song
 }
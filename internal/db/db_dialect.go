@@ -0,0 +1,92 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// dialect is what differs between the SQL databases behind songSQL. The
+// other queries are written in the SQL both databases understand.
+type dialect interface {
+	// search is SongDB.Search.
+	search(ctx context.Context, q queryer, query string, limit int) ([]*data.Song, error)
+	// timeArg returns the argument compared with a stored time.
+	timeArg(t time.Time) any
+	// constraintError returns ErrorUniqueViolation or ErrorForeignKeyViolation
+	// for the driver error of a violated constraint, other errors as they are.
+	constraintError(err error) error
+}
+
+type postgresDialect struct{}
+
+// search matches the generated tsvector of the song and falls back to the
+// trigram similarity of pg_trgm.
+func (postgresDialect) search(ctx context.Context, q queryer, query string, limit int) ([]*data.Song, error) {
+	tsQuery := prefixTSQuery(query)
+	if tsQuery == "" {
+		return nil, nil
+	}
+
+	ranked := `
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
+		FROM songs
+		WHERE search @@ to_tsquery('simple', $1)
+		ORDER BY ts_rank(search, to_tsquery('simple', $1)) DESC, id
+		LIMIT $2
+	`
+
+	songs, err := querySongs(ctx, q, ranked, tsQuery, limit)
+	if err != nil || len(songs) > 0 {
+		return songs, err
+	}
+
+	similar := `
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
+		FROM songs
+		WHERE title % $1 OR artist % $1 OR album % $1
+		ORDER BY GREATEST(similarity(title, $1), similarity(artist, $1), similarity(album, $1)) DESC, id
+		LIMIT $2
+	`
+
+	return querySongs(ctx, q, similar, strings.TrimSpace(query), limit)
+}
+
+func (postgresDialect) timeArg(t time.Time) any {
+	return t
+}
+
+// The SQLSTATE codes of the violated constraints.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+func (postgresDialect) constraintError(err error) error {
+	var pgErr *pq.Error
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return ErrorUniqueViolation
+	case pgForeignKeyViolation:
+		return ErrorForeignKeyViolation
+	}
+	return err
+}
+
+// prefixTSQuery turns the words of the query into a tsquery where every word
+// is a prefix, "bohem rhap" becomes "bohem:* & rhap:*".
+func prefixTSQuery(query string) string {
+	words := searchWords(query)
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
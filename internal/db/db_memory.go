@@ -0,0 +1,658 @@
+package db_song
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"MusicPlayerProject/internal/data"
+)
+
+// similarityThreshold is the least trigram similarity of a similar song, the
+// default of pg_trgm.
+const similarityThreshold = 0.3
+
+// memoryData is the content of the tables. The stored songs are never
+// changed, an update stores a new copy, so a shallow clone is a snapshot.
+type memoryData struct {
+	songs      map[int]*data.Song
+	ordinals   map[int]int
+	lastSongID int
+
+	playlists      map[int]string
+	lastPlaylistID int
+	// entries are the songs of the playlists in the order of their IDs
+	entries     []memoryEntry
+	lastEntryID int
+
+	state *data.PlayerState
+}
+
+type memoryEntry struct {
+	id         int
+	playlistID int
+	songID     int
+	ordinal    int
+}
+
+func (d *memoryData) clone() *memoryData {
+	c := *d
+	c.songs = maps.Clone(d.songs)
+	c.ordinals = maps.Clone(d.ordinals)
+	c.playlists = maps.Clone(d.playlists)
+	c.entries = slices.Clone(d.entries)
+	return &c
+}
+
+// songMemory is the SongDB kept in memory, for the tests and the demos. It
+// follows the SQL backends: the same songs are found in the same order and
+// the same operations fail, but nothing survives the process.
+type songMemory struct {
+	// mu guards the committed data, a transaction holds it until Commit or
+	// Rollback.
+	mu   *sync.Mutex
+	data *memoryData
+	// tx is set in the SongDB of a memoryTx.
+	tx *memoryTx
+}
+
+func NewMemorySongDB() SongDB {
+	return &songMemory{
+		mu: &sync.Mutex{},
+		data: &memoryData{
+			songs:     map[int]*data.Song{},
+			ordinals:  map[int]int{},
+			playlists: map[int]string{},
+		},
+	}
+}
+
+// lock locks the data for an operation and returns the unlock. The
+// operations of a transaction run under the lock it holds.
+func (r *songMemory) lock() func() {
+	if r.tx != nil {
+		return func() {}
+	}
+	r.mu.Lock()
+	return r.mu.Unlock
+}
+
+// memoryTx works on a clone of the data, which replaces the committed data
+// on Commit. The transactions and the other operations wait for it to end.
+type memoryTx struct {
+	*songMemory
+	committed *memoryData
+	done      bool
+}
+
+func (r *songMemory) BeginTx(ctx context.Context) (SongTx, error) {
+	if r.tx != nil {
+		return nil, ErrorNestedTx
+	}
+
+	r.mu.Lock()
+	tx := &memoryTx{committed: r.data}
+	tx.songMemory = &songMemory{mu: r.mu, data: r.data.clone(), tx: tx}
+	return tx, nil
+}
+
+func (t *memoryTx) Commit() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+
+	*t.committed = *t.data
+	t.mu.Unlock()
+	return nil
+}
+
+func (t *memoryTx) Rollback() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+
+	t.mu.Unlock()
+	return nil
+}
+
+func (r *songMemory) Create(ctx context.Context, song *data.Song) (int, error) {
+	defer r.lock()()
+
+	if r.data.find(song.Artist, song.Title, song.Album) != nil {
+		return 0, ErrorUniqueViolation
+	}
+
+	r.data.lastSongID++
+	stored := copySong(song)
+	stored.ID = r.data.lastSongID
+	stored.CreatedAt = time.Now()
+
+	r.data.songs[stored.ID] = stored
+	r.data.ordinals[stored.ID] = maxValue(r.data.ordinals) + 1
+	return stored.ID, nil
+}
+
+// Get returns the first song with the title or nil if there is no such song.
+func (r *songMemory) Get(ctx context.Context, title string) (*data.Song, error) {
+	defer r.lock()()
+
+	return copySong(r.data.get(title)), nil
+}
+
+// Find returns the song with the artist, title and album or nil if there is no such song.
+func (r *songMemory) Find(ctx context.Context, artist string, title string, album string) (*data.Song, error) {
+	defer r.lock()()
+
+	return copySong(r.data.find(artist, title, album)), nil
+}
+
+// GetByID returns the song with the ID or nil if there is no such song.
+func (r *songMemory) GetByID(ctx context.Context, id int) (*data.Song, error) {
+	defer r.lock()()
+
+	return copySong(r.data.songs[id]), nil
+}
+
+func (r *songMemory) Update(ctx context.Context, oldTitle string, song *data.Song) error {
+	defer r.lock()()
+
+	stored := r.data.get(oldTitle)
+	if stored == nil {
+		return errors.New("No rows updated, check the song title")
+	}
+	return r.data.update(stored, song)
+}
+
+func (r *songMemory) UpdateByID(ctx context.Context, id int, song *data.Song) error {
+	defer r.lock()()
+
+	stored, ok := r.data.songs[id]
+	if !ok {
+		return errors.New("No rows updated, check the song ID")
+	}
+	return r.data.update(stored, song)
+}
+
+func (r *songMemory) Delete(ctx context.Context, title string) error {
+	defer r.lock()()
+
+	song := r.data.get(title)
+	if song == nil {
+		return errors.New("No rows deleted, check the song ID")
+	}
+	r.data.delete(song.ID)
+	return nil
+}
+
+func (r *songMemory) DeleteByID(ctx context.Context, id int) error {
+	defer r.lock()()
+
+	if _, ok := r.data.songs[id]; !ok {
+		return errors.New("No rows deleted, check the song ID")
+	}
+	r.data.delete(id)
+	return nil
+}
+
+func (r *songMemory) List(ctx context.Context) ([]*data.Song, error) {
+	defer r.lock()()
+
+	songs := r.data.all()
+	slices.SortFunc(songs, func(a, b *data.Song) int {
+		if c := r.data.ordinals[a.ID] - r.data.ordinals[b.ID]; c != 0 {
+			return c
+		}
+		return a.ID - b.ID
+	})
+	return copySongs(songs), nil
+}
+
+// ListPage returns a page of the library, see songSQL.ListPage.
+func (r *songMemory) ListPage(ctx context.Context, opts data.SongListOptions) ([]*data.Song, error) {
+	if _, ok := songOrderColumns[opts.OrderBy]; !ok {
+		return nil, fmt.Errorf("unknown song order %d", opts.OrderBy)
+	}
+
+	defer r.lock()()
+
+	compare := func(a, b *data.Song) int {
+		c := compareSongs(opts.OrderBy, a, b)
+		if opts.Descending {
+			return -c
+		}
+		return c
+	}
+
+	var songs []*data.Song
+	for _, song := range r.data.songs {
+		seconds := int64(song.Duration.Seconds())
+		switch {
+		case opts.MinDuration > 0 && seconds < int64(opts.MinDuration.Seconds()):
+		case opts.MaxDuration > 0 && seconds > int64(opts.MaxDuration.Seconds()):
+		case !strings.HasPrefix(song.Title, opts.TitlePrefix):
+		case opts.After != nil && compare(song, opts.After) <= 0:
+		default:
+			songs = append(songs, song)
+		}
+	}
+
+	slices.SortFunc(songs, compare)
+	if len(songs) > opts.Limit {
+		songs = songs[:opts.Limit]
+	}
+	return copySongs(songs), nil
+}
+
+// Search returns the best matches of the query as the SQL backends rank
+// them: every word of the query starts a word of the title, the artist or
+// the album, and the title weighs more than the artist and the artist more
+// than the album. When nothing matches, the songs with a word similar to the
+// query are returned instead.
+func (r *songMemory) Search(ctx context.Context, query string, limit int) ([]*data.Song, error) {
+	words := searchWords(query)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	defer r.lock()()
+
+	ranks := map[int]float64{}
+	for id, song := range r.data.songs {
+		if rank, ok := matchRank(song, words); ok {
+			ranks[id] = rank
+		}
+	}
+
+	if len(ranks) == 0 {
+		queryTrigrams := trigrams(words)
+		for id, song := range r.data.songs {
+			if rank := similarity(song, queryTrigrams); rank >= similarityThreshold {
+				ranks[id] = rank
+			}
+		}
+	}
+
+	songs := make([]*data.Song, 0, len(ranks))
+	for id := range ranks {
+		songs = append(songs, r.data.songs[id])
+	}
+	slices.SortFunc(songs, func(a, b *data.Song) int {
+		if ranks[a.ID] != ranks[b.ID] {
+			if ranks[a.ID] > ranks[b.ID] {
+				return -1
+			}
+			return 1
+		}
+		return a.ID - b.ID
+	})
+	if len(songs) > limit {
+		songs = songs[:limit]
+	}
+	return copySongs(songs), nil
+}
+
+// SetOrder stores the order of the songs: ids[i] gets the ordinal i + 1.
+func (r *songMemory) SetOrder(ctx context.Context, ids []int) error {
+	defer r.lock()()
+
+	for i, id := range ids {
+		if _, ok := r.data.songs[id]; ok {
+			r.data.ordinals[id] = i + 1
+		}
+	}
+	return nil
+}
+
+func (r *songMemory) GetPlayerState(ctx context.Context) (*data.PlayerState, error) {
+	defer r.lock()()
+
+	if r.data.state == nil {
+		return nil, nil
+	}
+	state := *r.data.state
+	return &state, nil
+}
+
+func (r *songMemory) SavePlayerState(ctx context.Context, state *data.PlayerState) error {
+	defer r.lock()()
+
+	if _, ok := r.data.songs[state.SongID]; state.SongID != 0 && !ok {
+		return ErrorForeignKeyViolation
+	}
+
+	stored := *state
+	stored.Position = time.Duration(int64(state.Position.Seconds())) * time.Second
+	r.data.state = &stored
+	return nil
+}
+
+func (r *songMemory) CreatePlaylist(ctx context.Context, name string) (int, error) {
+	defer r.lock()()
+
+	if r.data.playlistNamed(name) {
+		return 0, ErrorUniqueViolation
+	}
+
+	r.data.lastPlaylistID++
+	r.data.playlists[r.data.lastPlaylistID] = name
+	return r.data.lastPlaylistID, nil
+}
+
+func (r *songMemory) GetPlaylist(ctx context.Context, id int) (*data.Playlist, error) {
+	defer r.lock()()
+
+	name, ok := r.data.playlists[id]
+	if !ok {
+		return nil, nil
+	}
+	return &data.Playlist{ID: id, Name: name}, nil
+}
+
+func (r *songMemory) ListPlaylists(ctx context.Context) ([]*data.Playlist, error) {
+	defer r.lock()()
+
+	var playlists []*data.Playlist
+	for _, id := range slices.Sorted(maps.Keys(r.data.playlists)) {
+		playlists = append(playlists, &data.Playlist{ID: id, Name: r.data.playlists[id]})
+	}
+	return playlists, nil
+}
+
+func (r *songMemory) RenamePlaylist(ctx context.Context, id int, name string) error {
+	defer r.lock()()
+
+	current, ok := r.data.playlists[id]
+	if !ok {
+		return errors.New("No rows updated, check the playlist ID")
+	}
+	if current != name && r.data.playlistNamed(name) {
+		return ErrorUniqueViolation
+	}
+
+	r.data.playlists[id] = name
+	return nil
+}
+
+func (r *songMemory) DeletePlaylist(ctx context.Context, id int) error {
+	defer r.lock()()
+
+	if _, ok := r.data.playlists[id]; !ok {
+		return errors.New("No rows deleted, check the playlist ID")
+	}
+
+	delete(r.data.playlists, id)
+	r.data.entries = slices.DeleteFunc(r.data.entries, func(e memoryEntry) bool {
+		return e.playlistID == id
+	})
+	return nil
+}
+
+// AddPlaylistSong appends the song to the end of the playlist.
+func (r *songMemory) AddPlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	defer r.lock()()
+
+	ordinal := 0
+	for _, entry := range r.data.entries {
+		if entry.playlistID == playlistID {
+			ordinal = max(ordinal, entry.ordinal)
+		}
+	}
+	return r.data.addEntry(playlistID, songID, ordinal+1)
+}
+
+// RemovePlaylistSong removes the first entry of the song from the playlist.
+func (r *songMemory) RemovePlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	defer r.lock()()
+
+	first := -1
+	for i, entry := range r.data.entries {
+		if entry.playlistID != playlistID || entry.songID != songID {
+			continue
+		}
+		if first < 0 || entry.ordinal < r.data.entries[first].ordinal {
+			first = i
+		}
+	}
+	if first < 0 {
+		return errors.New("No rows deleted, check the playlist and song IDs")
+	}
+
+	r.data.entries = slices.Delete(r.data.entries, first, first+1)
+	return nil
+}
+
+func (r *songMemory) ListPlaylistSongs(ctx context.Context, playlistID int) ([]*data.Song, error) {
+	defer r.lock()()
+
+	entries := slices.DeleteFunc(slices.Clone(r.data.entries), func(e memoryEntry) bool {
+		return e.playlistID != playlistID
+	})
+	// the entries are in the order of their IDs, the stable sort keeps it
+	// for the same ordinals
+	slices.SortStableFunc(entries, func(a, b memoryEntry) int {
+		return a.ordinal - b.ordinal
+	})
+
+	var songs []*data.Song
+	for _, entry := range entries {
+		songs = append(songs, copySong(r.data.songs[entry.songID]))
+	}
+	return songs, nil
+}
+
+// SetPlaylistSongs replaces the entries of the playlist with songIDs in the
+// given order. Nothing is changed when a song does not exist.
+func (r *songMemory) SetPlaylistSongs(ctx context.Context, playlistID int, songIDs []int) error {
+	defer r.lock()()
+
+	entries := slices.Clone(r.data.entries)
+	lastEntryID := r.data.lastEntryID
+
+	r.data.entries = slices.DeleteFunc(r.data.entries, func(e memoryEntry) bool {
+		return e.playlistID == playlistID
+	})
+	for i, id := range songIDs {
+		err := r.data.addEntry(playlistID, id, i+1)
+		if err != nil {
+			r.data.entries, r.data.lastEntryID = entries, lastEntryID
+			return err
+		}
+	}
+	return nil
+}
+
+// get returns the first song with the title or nil.
+func (d *memoryData) get(title string) *data.Song {
+	var first *data.Song
+	for _, song := range d.songs {
+		if song.Title == title && (first == nil || song.ID < first.ID) {
+			first = song
+		}
+	}
+	return first
+}
+
+// find returns the song with the artist, title and album or nil.
+func (d *memoryData) find(artist string, title string, album string) *data.Song {
+	for _, song := range d.songs {
+		if song.Artist == artist && song.Title == title && song.Album == album {
+			return song
+		}
+	}
+	return nil
+}
+
+// update stores the title, the duration and the metadata of song in the
+// stored one, the ID and the creation time are kept.
+func (d *memoryData) update(stored *data.Song, song *data.Song) error {
+	other := d.find(song.Artist, song.Title, song.Album)
+	if other != nil && other.ID != stored.ID {
+		return ErrorUniqueViolation
+	}
+
+	updated := copySong(song)
+	updated.ID = stored.ID
+	updated.CreatedAt = stored.CreatedAt
+	d.songs[stored.ID] = updated
+	return nil
+}
+
+// delete deletes the song with its entries in the playlists and unsets it in
+// the player state.
+func (d *memoryData) delete(id int) {
+	delete(d.songs, id)
+	delete(d.ordinals, id)
+
+	d.entries = slices.DeleteFunc(d.entries, func(e memoryEntry) bool {
+		return e.songID == id
+	})
+	if d.state != nil && d.state.SongID == id {
+		state := *d.state
+		state.SongID = 0
+		d.state = &state
+	}
+}
+
+func (d *memoryData) all() []*data.Song {
+	return slices.Collect(maps.Values(d.songs))
+}
+
+func (d *memoryData) playlistNamed(name string) bool {
+	for _, other := range d.playlists {
+		if other == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *memoryData) addEntry(playlistID int, songID int, ordinal int) error {
+	if _, ok := d.playlists[playlistID]; !ok {
+		return ErrorForeignKeyViolation
+	}
+	if _, ok := d.songs[songID]; !ok {
+		return ErrorForeignKeyViolation
+	}
+
+	d.lastEntryID++
+	d.entries = append(d.entries, memoryEntry{id: d.lastEntryID, playlistID: playlistID, songID: songID, ordinal: ordinal})
+	return nil
+}
+
+// compareSongs compares the songs by the order and then by the ID.
+func compareSongs(order data.SongOrder, a *data.Song, b *data.Song) int {
+	c := 0
+	switch order {
+	case data.SongOrderTitle:
+		c = strings.Compare(a.Title, b.Title)
+	case data.SongOrderDuration:
+		c = int(int64(a.Duration.Seconds()) - int64(b.Duration.Seconds()))
+	case data.SongOrderCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return a.ID - b.ID
+}
+
+// matchRank returns the rank of the song when every word is a prefix of a
+// word of the song.
+func matchRank(song *data.Song, words []string) (float64, bool) {
+	// the weights are the ones ts_rank gives to A, B and C
+	fields := []struct {
+		words  []string
+		weight float64
+	}{
+		{searchWords(song.Title), 1.0},
+		{searchWords(song.Artist), 0.4},
+		{searchWords(song.Album), 0.2},
+	}
+
+	rank := 0.0
+	for _, word := range words {
+		found := false
+		for _, field := range fields {
+			if slices.ContainsFunc(field.words, func(w string) bool { return strings.HasPrefix(w, word) }) {
+				rank += field.weight
+				found = true
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return rank, true
+}
+
+// similarity returns the greatest similarity of the trigrams to a word of
+// the title, the artist or the album.
+func similarity(song *data.Song, queryTrigrams map[string]bool) float64 {
+	best := 0.0
+	for _, field := range []string{song.Title, song.Artist, song.Album} {
+		for _, word := range searchWords(field) {
+			wordTrigrams := trigrams([]string{word})
+
+			common := 0
+			for trigram := range wordTrigrams {
+				if queryTrigrams[trigram] {
+					common++
+				}
+			}
+			best = max(best, float64(common)/float64(len(wordTrigrams)+len(queryTrigrams)-common))
+		}
+	}
+	return best
+}
+
+// trigrams returns the trigrams of the words padded as pg_trgm pads them,
+// "ab" has "  a", " ab" and "ab ".
+func trigrams(words []string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range words {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// copySong returns a copy of the song the caller may change, the tags of a
+// song without them are nil as the SQL backends return them.
+func copySong(song *data.Song) *data.Song {
+	if song == nil {
+		return nil
+	}
+
+	c := *song
+	c.Tags = nil
+	if len(song.Tags) > 0 {
+		c.Tags = maps.Clone(song.Tags)
+	}
+	return &c
+}
+
+func copySongs(songs []*data.Song) []*data.Song {
+	var copies []*data.Song
+	for _, song := range songs {
+		copies = append(copies, copySong(song))
+	}
+	return copies
+}
+
+func maxValue(m map[int]int) int {
+	result := 0
+	for _, v := range m {
+		result = max(result, v)
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryConstraints(t *testing.T) {
+	dbsong := NewMemorySongDB()
+	ctx := context.Background()
+
+	id, err := dbsong.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+	assert.NoError(t, err, "unexpected error when creating a song")
+	_, err = dbsong.Create(ctx, &data.Song{Title: "Song 2", Duration: time.Minute})
+	assert.NoError(t, err, "unexpected error when creating a song")
+
+	_, err = dbsong.Create(ctx, &data.Song{Title: "Song 1", Duration: 2 * time.Minute})
+	assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation, but got: %v", err)
+	err = dbsong.UpdateByID(ctx, id, &data.Song{Title: "Song 2", Duration: time.Minute})
+	assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation, but got: %v", err)
+
+	playlistID, err := dbsong.CreatePlaylist(ctx, "Playlist 1")
+	assert.NoError(t, err, "unexpected error when creating a playlist")
+	_, err = dbsong.CreatePlaylist(ctx, "Playlist 1")
+	assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation, but got: %v", err)
+
+	err = dbsong.AddPlaylistSong(ctx, playlistID, 42)
+	assert.ErrorIs(t, err, ErrorForeignKeyViolation, "expected ErrorForeignKeyViolation, but got: %v", err)
+	err = dbsong.SavePlayerState(ctx, &data.PlayerState{SongID: 42})
+	assert.ErrorIs(t, err, ErrorForeignKeyViolation, "expected ErrorForeignKeyViolation, but got: %v", err)
+
+	// the entries are kept when one of the new songs does not exist
+	assert.NoError(t, dbsong.AddPlaylistSong(ctx, playlistID, id), "unexpected error when adding a song")
+	err = dbsong.SetPlaylistSongs(ctx, playlistID, []int{id, 42})
+	assert.ErrorIs(t, err, ErrorForeignKeyViolation, "expected ErrorForeignKeyViolation, but got: %v", err)
+	songs, err := dbsong.ListPlaylistSongs(ctx, playlistID)
+	assert.NoError(t, err, "unexpected error when listing the playlist songs")
+	assert.Len(t, songs, 1, "expected the entries to be kept")
+
+	// the returned songs are copies
+	songs[0].Title = "Changed"
+	song, err := dbsong.GetByID(ctx, id)
+	assert.NoError(t, err, "unexpected error when getting a song")
+	assert.Equal(t, "Song 1", song.Title, "expected the stored song not to change")
+}
+
+func TestMemoryTx(t *testing.T) {
+	dbsong := NewMemorySongDB()
+	ctx := context.Background()
+
+	tx, err := dbsong.BeginTx(ctx)
+	assert.NoError(t, err, "unexpected error when beginning a transaction")
+	_, err = tx.BeginTx(ctx)
+	assert.ErrorIs(t, err, ErrorNestedTx, "expected ErrorNestedTx for a nested transaction")
+
+	id, err := tx.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+	assert.NoError(t, err, "unexpected error when creating a song")
+
+	// the others see the song after the commit only
+	created := make(chan *data.Song)
+	go func() {
+		song, _ := dbsong.GetByID(ctx, id)
+		created <- song
+	}()
+
+	assert.NoError(t, tx.Commit(), "unexpected error when committing")
+	assert.NotNil(t, <-created, "expected the committed song")
+	assert.ErrorIs(t, tx.Rollback(), sql.ErrTxDone, "expected sql.ErrTxDone after the commit")
+}
+
+func TestMemoryConcurrency(t *testing.T) {
+	dbsong := NewMemorySongDB()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			tx, err := dbsong.BeginTx(ctx)
+			assert.NoError(t, err, "unexpected error when beginning a transaction")
+			_, err = tx.Create(ctx, &data.Song{Title: fmt.Sprintf("Song %d", i), Duration: time.Minute})
+			assert.NoError(t, err, "unexpected error when creating a song")
+			assert.NoError(t, tx.Commit(), "unexpected error when committing")
+
+			_, err = dbsong.List(ctx)
+			assert.NoError(t, err, "unexpected error when listing songs")
+		}()
+	}
+	wg.Wait()
+
+	songs, err := dbsong.List(ctx)
+	assert.NoError(t, err, "unexpected error when listing songs")
+	assert.Len(t, songs, 10, "expected every song to be created once")
+}
@@ -0,0 +1,200 @@
+package db_song
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"MusicPlayerProject/internal/data"
+)
+
+func (r *songSQL) CreatePlaylist(ctx context.Context, name string) (int, error) {
+	var id int
+	query := `
+		INSERT INTO playlists (name)
+		VALUES ($1)
+		RETURNING id
+	`
+	err := r.q.QueryRowContext(ctx, query, name).Scan(&id)
+	if err != nil {
+		return 0, r.dialect.constraintError(err)
+	}
+	return id, nil
+}
+
+func (r *songSQL) GetPlaylist(ctx context.Context, id int) (*data.Playlist, error) {
+	query := `
+		SELECT id, name
+		FROM playlists
+		WHERE id = $1
+	`
+
+	var playlist data.Playlist
+	err := r.q.QueryRowContext(ctx, query, id).Scan(&playlist.ID, &playlist.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &playlist, nil
+}
+
+func (r *songSQL) ListPlaylists(ctx context.Context) ([]*data.Playlist, error) {
+	query := `
+		SELECT id, name
+		FROM playlists
+		ORDER BY id
+	`
+
+	rows, err := r.q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var playlists []*data.Playlist
+
+	for rows.Next() {
+		var playlist data.Playlist
+
+		err = rows.Scan(&playlist.ID, &playlist.Name)
+		if err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, &playlist)
+	}
+
+	return playlists, rows.Err()
+}
+
+func (r *songSQL) RenamePlaylist(ctx context.Context, id int, name string) error {
+	query := `
+		UPDATE playlists
+		SET name = $2
+		WHERE id = $1
+	`
+
+	res, err := r.q.ExecContext(ctx, query, id, name)
+	if err != nil {
+		return r.dialect.constraintError(err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("No rows updated, check the playlist ID")
+	}
+
+	return nil
+}
+
+func (r *songSQL) DeletePlaylist(ctx context.Context, id int) error {
+	query := `
+		DELETE FROM playlists
+		WHERE id = $1
+	`
+
+	res, err := r.q.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("No rows deleted, check the playlist ID")
+	}
+
+	return nil
+}
+
+// AddPlaylistSong appends the song to the end of the playlist.
+func (r *songSQL) AddPlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	query := `
+		INSERT INTO playlist_songs (playlist_id, song_id, ordinal)
+		VALUES ($1, $2, (SELECT COALESCE(MAX(ordinal), 0) + 1 FROM playlist_songs WHERE playlist_id = $1))
+	`
+
+	_, err := r.q.ExecContext(ctx, query, playlistID, songID)
+	return r.dialect.constraintError(err)
+}
+
+// RemovePlaylistSong removes the first entry of the song from the playlist.
+// The same song may be added to a playlist more than once.
+func (r *songSQL) RemovePlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	query := `
+		DELETE FROM playlist_songs
+		WHERE id = (
+			SELECT id
+			FROM playlist_songs
+			WHERE playlist_id = $1 AND song_id = $2
+			ORDER BY ordinal
+			LIMIT 1
+		)
+	`
+
+	res, err := r.q.ExecContext(ctx, query, playlistID, songID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("No rows deleted, check the playlist and song IDs")
+	}
+
+	return nil
+}
+
+func (r *songSQL) ListPlaylistSongs(ctx context.Context, playlistID int) ([]*data.Song, error) {
+	query := `
+		SELECT s.id, s.title, s.duration, s.artist, s.album, s.album_artist, s.track_number, s.disc_number, s.genre, s.year, s.tags, s.created_at
+		FROM playlist_songs ps
+		JOIN songs s ON s.id = ps.song_id
+		WHERE ps.playlist_id = $1
+		ORDER BY ps.ordinal, ps.id
+	`
+
+	rows, err := r.q.QueryContext(ctx, query, playlistID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSongs(rows)
+}
+
+// SetPlaylistSongs replaces the entries of the playlist with songIDs in the
+// given order. A song may be listed more than once.
+func (r *songSQL) SetPlaylistSongs(ctx context.Context, playlistID int, songIDs []int) error {
+	return r.withTx(ctx, func(tx queryer) error {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM playlist_songs
+			WHERE playlist_id = $1
+		`, playlistID)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			INSERT INTO playlist_songs (playlist_id, song_id, ordinal)
+			VALUES ($1, $2, $3)
+		`
+
+		for i, id := range songIDs {
+			_, err = tx.ExecContext(ctx, query, playlistID, id, i+1)
+			if err != nil {
+				return r.dialect.constraintError(err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,167 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePlaylist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectQuery("INSERT INTO playlists").
+		WithArgs("Favourites").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	id, err := dbsong.CreatePlaylist(ctx, "Favourites")
+	assert.NoError(t, err, "unexpected error when creating a playlist")
+	assert.Equal(t, 1, id, "expected playlist ID to be 1")
+
+	// the violated constraint is translated from the driver error
+	mock.ExpectQuery("INSERT INTO playlists").
+		WithArgs("Favourites").
+		WillReturnError(&pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint"})
+
+	_, err = dbsong.CreatePlaylist(ctx, "Favourites")
+	assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation, but got: %v", err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPlaylist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT id, name FROM playlists WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Favourites"))
+
+	playlist, err := dbsong.GetPlaylist(ctx, 1)
+	assert.NoError(t, err, "unexpected error when getting a playlist")
+	assert.Equal(t, &data.Playlist{ID: 1, Name: "Favourites"}, playlist, "expected playlist to match")
+
+	mock.ExpectQuery("SELECT id, name FROM playlists WHERE id = \\$1").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	playlist, err = dbsong.GetPlaylist(ctx, 2)
+	assert.NoError(t, err, "unexpected error when getting a missing playlist")
+	assert.Nil(t, playlist, "expected no playlist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRenamePlaylist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE playlists SET name = \\$2 WHERE id = \\$1").
+		WithArgs(1, "Road trip").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = dbsong.RenamePlaylist(ctx, 1, "Road trip")
+	assert.NoError(t, err, "unexpected error when renaming a playlist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeletePlaylist(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectExec("DELETE FROM playlists WHERE id = \\$1").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = dbsong.DeletePlaylist(ctx, 1)
+	assert.Error(t, err, "expected error when deleting a missing playlist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistSongs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO playlist_songs").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = dbsong.AddPlaylistSong(ctx, 1, 2)
+	assert.NoError(t, err, "unexpected error when adding a song to a playlist")
+
+	mock.ExpectQuery("SELECT (.+) FROM playlist_songs ps JOIN songs s").
+		WithArgs(1).
+		WillReturnRows(songRows(&data.Song{ID: 2, Title: "Song 2", Duration: 2 * time.Minute}))
+
+	songs, err := dbsong.ListPlaylistSongs(ctx, 1)
+	assert.NoError(t, err, "unexpected error when listing playlist songs")
+	assert.Equal(t, []*data.Song{{ID: 2, Title: "Song 2", Duration: 2 * time.Minute}}, songs, "expected songs to match")
+
+	mock.ExpectExec("DELETE FROM playlist_songs WHERE id = \\( SELECT id FROM playlist_songs WHERE playlist_id = \\$1 AND song_id = \\$2 ORDER BY ordinal LIMIT 1 \\)").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = dbsong.RemovePlaylistSong(ctx, 1, 2)
+	assert.NoError(t, err, "unexpected error when removing a song from a playlist")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetPlaylistSongs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM playlist_songs WHERE playlist_id = \\$1").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO playlist_songs \\(playlist_id, song_id, ordinal\\) VALUES \\(\\$1, \\$2, \\$3\\)").
+		WithArgs(1, 3, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO playlist_songs \\(playlist_id, song_id, ordinal\\) VALUES \\(\\$1, \\$2, \\$3\\)").
+		WithArgs(1, 3, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = dbsong.SetPlaylistSongs(ctx, 1, []int{3, 3})
+	assert.NoError(t, err, "unexpected error when setting the playlist songs")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,72 +3,201 @@ package db_song
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"MusicPlayerProject/internal/data"
 )
 
+// The errors of the violated constraints. The SQL backends translate the
+// errors of their drivers into them, the memory one returns them itself.
+var (
+	ErrorUniqueViolation     = errors.New("The row with the same unique values already exists")
+	ErrorForeignKeyViolation = errors.New("The referenced row does not exist")
+)
+
 type SongDB interface {
 	Create(ctx context.Context, song *data.Song) (int, error)
 	Get(ctx context.Context, title string) (*data.Song, error)
-	Update(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error
+	Find(ctx context.Context, artist string, title string, album string) (*data.Song, error)
+	GetByID(ctx context.Context, id int) (*data.Song, error)
+	// Update and UpdateByID store the title, the duration and the metadata
+	// of song in the addressed song, the ID of song is not used.
+	Update(ctx context.Context, oldTitle string, song *data.Song) error
+	UpdateByID(ctx context.Context, id int, song *data.Song) error
 	Delete(ctx context.Context, title string) error
+	DeleteByID(ctx context.Context, id int) error
 	List(ctx context.Context) ([]*data.Song, error)
+	ListPage(ctx context.Context, opts data.SongListOptions) ([]*data.Song, error)
+	Search(ctx context.Context, query string, limit int) ([]*data.Song, error)
+	SetOrder(ctx context.Context, ids []int) error
+	GetPlayerState(ctx context.Context) (*data.PlayerState, error)
+	SavePlayerState(ctx context.Context, state *data.PlayerState) error
+
+	CreatePlaylist(ctx context.Context, name string) (int, error)
+	GetPlaylist(ctx context.Context, id int) (*data.Playlist, error)
+	ListPlaylists(ctx context.Context) ([]*data.Playlist, error)
+	RenamePlaylist(ctx context.Context, id int, name string) error
+	DeletePlaylist(ctx context.Context, id int) error
+	AddPlaylistSong(ctx context.Context, playlistID int, songID int) error
+	RemovePlaylistSong(ctx context.Context, playlistID int, songID int) error
+	ListPlaylistSongs(ctx context.Context, playlistID int) ([]*data.Song, error)
+	SetPlaylistSongs(ctx context.Context, playlistID int, songIDs []int) error
+
+	// BeginTx starts a transaction, the operations of the returned SongTx
+	// run in it.
+	BeginTx(ctx context.Context) (SongTx, error)
 }
 
-type songPostgreSQL struct {
+// songSQL is the SongDB of a SQL database, the queries which differ between
+// the databases are left to the dialect.
+type songSQL struct {
 	db *sql.DB
+	// q is db or the transaction of a songTx.
+	q       queryer
+	tx      *sql.Tx
+	dialect dialect
 }
 
+// NewSongDB returns the SongDB stored in PostgreSQL.
 func NewSongDB(db *sql.DB) SongDB {
-	return &songPostgreSQL{db: db}
+	return &songSQL{db: db, q: db, dialect: postgresDialect{}}
 }
 
-func (r *songPostgreSQL) Create(ctx context.Context, song *data.Song) (int, error) {
+func (r *songSQL) Create(ctx context.Context, song *data.Song) (int, error) {
+	tags, err := encodeTags(song.Tags)
+	if err != nil {
+		return 0, err
+	}
+
 	var id int
 	query := `
-		INSERT INTO songs (title, duration)
-		VALUES ($1, $2)
+		INSERT INTO songs (title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, ordinal)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, (SELECT COALESCE(MAX(ordinal), 0) + 1 FROM songs))
 		RETURNING id
 	`
-	err := r.db.QueryRowContext(ctx, query, song.Title, song.Duration.Seconds()).Scan(&id)
+	err = r.q.QueryRowContext(ctx, query, song.Title, song.Duration.Seconds(), song.Artist, song.Album, song.AlbumArtist,
+		song.TrackNumber, song.DiscNumber, song.Genre, song.Year, tags).Scan(&id)
 	if err != nil {
-		return 0, err
+		return 0, r.dialect.constraintError(err)
 	}
 	return id, nil
 }
 
-func (r *songPostgreSQL) Get(ctx context.Context, title string) (*data.Song, error) {
+// Get returns the first song with the title or nil if there is no such song.
+// The title alone does not identify a song, see Find.
+func (r *songSQL) Get(ctx context.Context, title string) (*data.Song, error) {
 	query := `
-		SELECT id, title, duration
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
 		FROM songs
 		WHERE title = $1
+		ORDER BY id
+		LIMIT 1
 	`
 
-	var song data.Song
-	var durationSeconds int64
+	song, err := scanSong(r.q.QueryRowContext(ctx, query, title))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return song, err
+}
+
+// Find returns the song with the artist, title and album or nil if there is no such song.
+func (r *songSQL) Find(ctx context.Context, artist string, title string, album string) (*data.Song, error) {
+	query := `
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
+		FROM songs
+		WHERE artist = $1 AND title = $2 AND album = $3
+	`
+
+	song, err := scanSong(r.q.QueryRowContext(ctx, query, artist, title, album))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return song, err
+}
+
+// GetByID returns the song with the ID or nil if there is no such song.
+func (r *songSQL) GetByID(ctx context.Context, id int) (*data.Song, error) {
+	query := `
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
+		FROM songs
+		WHERE id = $1
+	`
+
+	song, err := scanSong(r.q.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return song, err
+}
 
-	err := r.db.QueryRowContext(ctx, query, title).Scan(&song.ID, &song.Title, &durationSeconds)
+func (r *songSQL) Update(ctx context.Context, oldTitle string, song *data.Song) error {
+	// the same song as Get returns is updated
+	query := `
+		UPDATE songs
+		SET title = $2, duration = $3, artist = $4, album = $5, album_artist = $6,
+			track_number = $7, disc_number = $8, genre = $9, year = $10, tags = $11
+		WHERE id = (SELECT id FROM songs WHERE title = $1 ORDER BY id LIMIT 1)
+	`
+
+	rowsAffected, err := r.update(ctx, query, oldTitle, song)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, err
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("No rows updated, check the song title")
 	}
 
-	song.Duration = time.Duration(durationSeconds) * time.Second
-	return &song, nil
+	return nil
 }
 
-func (r *songPostgreSQL) Update(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error {
+func (r *songSQL) UpdateByID(ctx context.Context, id int, song *data.Song) error {
 	query := `
 		UPDATE songs
-		SET title = $2, duration = $3
-		WHERE title = $1
+		SET title = $2, duration = $3, artist = $4, album = $5, album_artist = $6,
+			track_number = $7, disc_number = $8, genre = $9, year = $10, tags = $11
+		WHERE id = $1
+	`
+
+	rowsAffected, err := r.update(ctx, query, id, song)
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("No rows updated, check the song ID")
+	}
+
+	return nil
+}
+
+// update runs the update query of the song addressed by key and returns
+// the number of the updated rows.
+func (r *songSQL) update(ctx context.Context, query string, key any, song *data.Song) (int64, error) {
+	tags, err := encodeTags(song.Tags)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.q.ExecContext(ctx, query, key, song.Title, song.Duration.Seconds(), song.Artist, song.Album,
+		song.AlbumArtist, song.TrackNumber, song.DiscNumber, song.Genre, song.Year, tags)
+	if err != nil {
+		return 0, r.dialect.constraintError(err)
+	}
+	return res.RowsAffected()
+}
+
+func (r *songSQL) Delete(ctx context.Context, title string) error {
+	query := `
+		DELETE FROM songs
+		WHERE id = (SELECT id FROM songs WHERE title = $1 ORDER BY id LIMIT 1)
 	`
 
-	res, err := r.db.ExecContext(ctx, query, oldTitle, newTitle, duration.Seconds())
+	res, err := r.q.ExecContext(ctx, query, title)
 	if err != nil {
 		return err
 	}
@@ -78,19 +207,19 @@ func (r *songPostgreSQL) Update(ctx context.Context, oldTitle string, newTitle s
 		return err
 	}
 	if rowsAffected == 0 {
-		return errors.New("No rows updated, check the song title")
+		return errors.New("No rows deleted, check the song ID")
 	}
 
 	return nil
 }
 
-func (r *songPostgreSQL) Delete(ctx context.Context, title string) error {
+func (r *songSQL) DeleteByID(ctx context.Context, id int) error {
 	query := `
 		DELETE FROM songs
-		WHERE title = $1
+		WHERE id = $1
 	`
 
-	res, err := r.db.ExecContext(ctx, query, title)
+	res, err := r.q.ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
@@ -106,32 +235,258 @@ func (r *songPostgreSQL) Delete(ctx context.Context, title string) error {
 	return nil
 }
 
-func (r *songPostgreSQL) List(ctx context.Context) ([]*data.Song, error) {
+func (r *songSQL) List(ctx context.Context) ([]*data.Song, error) {
 	query := `
-		SELECT id, title, duration
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
 		FROM songs
+		ORDER BY ordinal, id
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.q.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanSongs(rows)
+}
+
+// songOrderColumns are the columns of the songs table a page can be sorted by.
+var songOrderColumns = map[data.SongOrder]string{
+	data.SongOrderID:        "id",
+	data.SongOrderTitle:     "title",
+	data.SongOrderDuration:  "duration",
+	data.SongOrderCreatedAt: "created_at",
+}
+
+// ListPage returns a page of the library. The page starts right after
+// opts.After, so the next page is read by passing the last song of the
+// previous one; this keeps deep pages as cheap as the first one.
+func (r *songSQL) ListPage(ctx context.Context, opts data.SongListOptions) ([]*data.Song, error) {
+	column, ok := songOrderColumns[opts.OrderBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown song order %d", opts.OrderBy)
+	}
+
+	var conditions []string
+	var args []any
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if opts.MinDuration > 0 {
+		conditions = append(conditions, "duration >= "+arg(int64(opts.MinDuration.Seconds())))
+	}
+	if opts.MaxDuration > 0 {
+		conditions = append(conditions, "duration <= "+arg(int64(opts.MaxDuration.Seconds())))
+	}
+	if opts.TitlePrefix != "" {
+		conditions = append(conditions, `title LIKE `+arg(likePrefix(opts.TitlePrefix))+` ESCAPE '\'`)
+	}
+
+	direction, comparison := "ASC", ">"
+	if opts.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if opts.After != nil {
+		if column == "id" {
+			conditions = append(conditions, "id "+comparison+" "+arg(opts.After.ID))
+		} else {
+			value := r.orderValue(opts.OrderBy, opts.After)
+			conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s, %s)", column, comparison, arg(value), arg(opts.After.ID)))
+		}
+	}
+
+	query := `
+		SELECT id, title, duration, artist, album, album_artist, track_number, disc_number, genre, year, tags, created_at
+		FROM songs
+	`
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+	if column == "id" {
+		query += "ORDER BY id " + direction + "\n"
+	} else {
+		query += fmt.Sprintf("ORDER BY %s %s, id %s\n", column, direction, direction)
+	}
+	query += "LIMIT " + arg(opts.Limit)
+
+	rows, err := r.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSongs(rows)
+}
+
+// Search returns the best matches of the query in the titles, artists and
+// albums, the title weighs more than the artist and the artist more than the
+// album. Every word of the query matches as a prefix, so partial titles are
+// found. When nothing matches, the songs with similar words are returned
+// instead to forgive typos.
+func (r *songSQL) Search(ctx context.Context, query string, limit int) ([]*data.Song, error) {
+	return r.dialect.search(ctx, r.q, query, limit)
+}
+
+// querySongs returns the songs selected with all the columns of the songs table.
+func querySongs(ctx context.Context, q queryer, query string, args ...any) ([]*data.Song, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSongs(rows)
+}
+
+// searchWords returns the lowercased words of the query. The punctuation is
+// dropped, so the user input cannot break the syntax of a full-text query.
+func searchWords(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// orderValue returns the value of the song in the order column.
+func (r *songSQL) orderValue(order data.SongOrder, song *data.Song) any {
+	switch order {
+	case data.SongOrderTitle:
+		return song.Title
+	case data.SongOrderDuration:
+		return int64(song.Duration.Seconds())
+	case data.SongOrderCreatedAt:
+		return r.dialect.timeArg(song.CreatedAt)
+	default:
+		return song.ID
+	}
+}
+
+// likePrefix returns the LIKE pattern matching the titles which start with
+// the prefix, the wildcards in the prefix are matched literally.
+func likePrefix(prefix string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(prefix) + "%"
+}
+
+// rowScanner is a *sql.Row or *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSong reads a song selected with all the columns of the songs table.
+func scanSong(row rowScanner) (*data.Song, error) {
+	var song data.Song
+	var durationSeconds int64
+	var tags []byte
+
+	err := row.Scan(&song.ID, &song.Title, &durationSeconds, &song.Artist, &song.Album, &song.AlbumArtist,
+		&song.TrackNumber, &song.DiscNumber, &song.Genre, &song.Year, &tags, &song.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	song.Duration = time.Duration(durationSeconds) * time.Second
+	song.Tags, err = decodeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	return &song, nil
+}
+
+func scanSongs(rows *sql.Rows) ([]*data.Song, error) {
 	var songs []*data.Song
 
 	for rows.Next() {
-		var song data.Song
-		var durationSeconds int64
-
-		err = rows.Scan(&song.ID, &song.Title, &durationSeconds)
+		song, err := scanSong(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		song.Duration = time.Duration(durationSeconds) * time.Second
-		songs = append(songs, &song)
+		songs = append(songs, song)
 	}
 
 	return songs, rows.Err()
 }
+
+// encodeTags returns the JSON object stored in the tags column.
+func encodeTags(tags map[string]string) ([]byte, error) {
+	if tags == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(tags)
+}
+
+// decodeTags returns nil for an empty object, as the songs without tags have.
+func decodeTags(raw []byte) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var tags map[string]string
+	err := json.Unmarshal(raw, &tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return tags, nil
+}
+
+func (r *songSQL) GetPlayerState(ctx context.Context) (*data.PlayerState, error) {
+	query := `
+		SELECT song_id, position, repeat_mode, shuffle, shuffle_seed
+		FROM player_state
+		WHERE id = 1
+	`
+
+	var state data.PlayerState
+	var songID sql.NullInt64
+	var positionSeconds int64
+
+	err := r.q.QueryRowContext(ctx, query).Scan(&songID, &positionSeconds, &state.RepeatMode, &state.Shuffle, &state.ShuffleSeed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	state.SongID = int(songID.Int64)
+	state.Position = time.Duration(positionSeconds) * time.Second
+	return &state, nil
+}
+
+func (r *songSQL) SavePlayerState(ctx context.Context, state *data.PlayerState) error {
+	query := `
+		INSERT INTO player_state (id, song_id, position, repeat_mode, shuffle, shuffle_seed)
+		VALUES (1, $1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE
+		SET song_id = $1, position = $2, repeat_mode = $3, shuffle = $4, shuffle_seed = $5
+	`
+
+	songID := sql.NullInt64{Int64: int64(state.SongID), Valid: state.SongID != 0}
+	_, err := r.q.ExecContext(ctx, query, songID, int64(state.Position.Seconds()), state.RepeatMode, state.Shuffle, state.ShuffleSeed)
+	return err
+}
+
+// SetOrder stores the order of the songs: ids[i] gets the ordinal i + 1.
+func (r *songSQL) SetOrder(ctx context.Context, ids []int) error {
+	query := `
+		UPDATE songs
+		SET ordinal = $2
+		WHERE id = $1
+	`
+
+	return r.withTx(ctx, func(tx queryer) error {
+		for i, id := range ids {
+			_, err := tx.ExecContext(ctx, query, id, i+1)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -3,6 +3,7 @@ package db_song
 import (
 	"MusicPlayerProject/internal/data"
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -10,122 +11,384 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCreateSong(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	defer db.Close()
-
-	dbsong := NewSongDB(db)
+var songColumns = []string{"id", "title", "duration", "artist", "album", "album_artist", "track_number", "disc_number", "genre", "year", "tags", "created_at"}
 
-	ctx := context.Background()
-	song := &data.Song{
-		Title:    "Test Song",
-		Duration: 3 * time.Minute,
+// songRows returns the rows of the songs as the songs table stores them.
+func songRows(songs ...*data.Song) *sqlmock.Rows {
+	rows := sqlmock.NewRows(songColumns)
+	for _, song := range songs {
+		tags, _ := encodeTags(song.Tags)
+		rows.AddRow(song.ID, song.Title, int64(song.Duration.Seconds()), song.Artist, song.Album, song.AlbumArtist,
+			song.TrackNumber, song.DiscNumber, song.Genre, song.Year, tags, song.CreatedAt)
 	}
+	return rows
+}
 
-	mock.ExpectQuery("INSERT INTO songs").
-		WithArgs(song.Title, song.Duration.Seconds()).
-		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+// backends are the SongDB constructors of the SQL databases. The queries the
+// dialects share are expected the same from both.
+var backends = []struct {
+	name  string
+	newDB func(db *sql.DB) SongDB
+}{
+	{"postgres", NewSongDB},
+	{"sqlite", NewSQLiteSongDB},
+}
 
-	id, err := dbsong.Create(ctx, song)
-	assert.NoError(t, err, "unexpected error when creating a song")
-	assert.Equal(t, 1, id, "expected song ID to be 1")
+// forEachBackend runs the test against every backend over a new sqlmock.
+func forEachBackend(t *testing.T, test func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB)) {
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer db.Close()
+
+			test(t, mock, backend.newDB(db))
+		})
+	}
+}
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+func TestCreateSong(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		song := &data.Song{
+			Title:       "Test Song",
+			Duration:    3 * time.Minute,
+			Artist:      "Test Artist",
+			Album:       "Test Album",
+			TrackNumber: 2,
+			Year:        1999,
+			Tags:        map[string]string{"mood": "calm"},
+		}
+
+		mock.ExpectQuery("INSERT INTO songs").
+			WithArgs(song.Title, song.Duration.Seconds(), "Test Artist", "Test Album", "", 2, 0, "", 1999, []byte(`{"mood":"calm"}`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery("INSERT INTO songs").
+			WithArgs("Other Song", float64(60), "", "", "", 0, 0, "", 0, []byte("{}")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+		id, err := dbsong.Create(ctx, song)
+		assert.NoError(t, err, "unexpected error when creating a song")
+		assert.Equal(t, 1, id, "expected song ID to be 1")
+
+		// a song without tags stores an empty object
+		id, err = dbsong.Create(ctx, &data.Song{Title: "Other Song", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		assert.Equal(t, 2, id, "expected song ID to be 2")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestGetSong(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	defer db.Close()
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		expectedSong := &data.Song{
+			ID:       1,
+			Title:    "Test Song",
+			Duration: 3 * time.Minute,
+			Artist:   "Test Artist",
+			Genre:    "Rock",
+			Tags:     map[string]string{"mood": "calm"},
+		}
+
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE title = \\$1 ORDER BY id LIMIT 1").
+			WithArgs("Test Song").
+			WillReturnRows(songRows(expectedSong))
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE artist = \\$1 AND title = \\$2 AND album = \\$3").
+			WithArgs("Test Artist", "Test Song", "").
+			WillReturnRows(songRows(expectedSong))
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE artist = \\$1 AND title = \\$2 AND album = \\$3").
+			WithArgs("Other Artist", "Test Song", "").
+			WillReturnRows(songRows())
+
+		song, err := dbsong.Get(ctx, "Test Song")
+		assert.NoError(t, err, "unexpected error when getting a song")
+		assert.Equal(t, expectedSong, song, "expected song to match")
+
+		song, err = dbsong.Find(ctx, "Test Artist", "Test Song", "")
+		assert.NoError(t, err, "unexpected error when finding a song")
+		assert.Equal(t, expectedSong, song, "expected song to match")
+
+		// the same title of another artist is another song
+		song, err = dbsong.Find(ctx, "Other Artist", "Test Song", "")
+		assert.NoError(t, err, "unexpected error when finding a missing song")
+		assert.Nil(t, song, "expected no song")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	dbsong := NewSongDB(db)
+func TestUpdateSong(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		song := &data.Song{
+			ID:       1,
+			Title:    "Test Song",
+			Duration: 4 * time.Minute,
+		}
+
+		updated := &data.Song{
+			Title:    "Updated Test Song",
+			Duration: 4 * time.Minute,
+			Artist:   "Test Artist",
+			Genre:    "Rock",
+			Tags:     map[string]string{"mood": "calm"},
+		}
+
+		mock.ExpectExec("UPDATE songs SET title = \\$2, duration = \\$3, artist = \\$4, (.+), tags = \\$11 WHERE id = \\(SELECT id FROM songs WHERE title = \\$1 ORDER BY id LIMIT 1\\)").
+			WithArgs(song.Title, "Updated Test Song", float64(240), "Test Artist", "", "", 0, 0, "Rock", 0, []byte(`{"mood":"calm"}`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := dbsong.Update(ctx, song.Title, updated)
+		assert.NoError(t, err, "unexpected error when updating a song")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	ctx := context.Background()
-	expectedSong := &data.Song{
-		ID:       1,
-		Title:    "Test Song",
-		Duration: 3 * time.Minute,
-	}
+func TestDeleteSong(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		songTitle := "song"
 
-	mock.ExpectQuery("SELECT id, title, duration FROM songs WHERE title = \\$1").
-		WithArgs("Test Song").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "duration"}).
-			AddRow(expectedSong.ID, expectedSong.Title, int64(expectedSong.Duration.Seconds())))
+		mock.ExpectExec("DELETE FROM songs WHERE id = \\(SELECT id FROM songs WHERE title = \\$1 ORDER BY id LIMIT 1\\)").
+			WithArgs(songTitle).
+			WillReturnResult(sqlmock.NewResult(0, 1)) // Одна строка удалена.
 
-	song, err := dbsong.Get(ctx, "Test Song")
-	assert.NoError(t, err, "unexpected error when getting a song")
-	assert.Equal(t, expectedSong, song, "expected song to match")
+		err := dbsong.Delete(ctx, songTitle)
+		assert.NoError(t, err, "unexpected error when deleting a song")
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func TestUpdateSong(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	defer db.Close()
+func TestSongByID(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		expectedSong := &data.Song{
+			ID:       2,
+			Title:    "Test Song",
+			Duration: 3 * time.Minute,
+		}
+
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE id = \\$1").
+			WithArgs(2).
+			WillReturnRows(songRows(expectedSong))
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE id = \\$1").
+			WithArgs(3).
+			WillReturnRows(songRows())
+		mock.ExpectExec("UPDATE songs SET title = \\$2, duration = \\$3, (.+) WHERE id = \\$1").
+			WithArgs(2, "Updated Test Song", float64(240), "", "", "", 0, 0, "", 0, []byte("{}")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM songs WHERE id = \\$1").
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM songs WHERE id = \\$1").
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		song, err := dbsong.GetByID(ctx, 2)
+		assert.NoError(t, err, "unexpected error when getting a song")
+		assert.Equal(t, expectedSong, song, "expected song to match")
+
+		song, err = dbsong.GetByID(ctx, 3)
+		assert.NoError(t, err, "unexpected error when getting a missing song")
+		assert.Nil(t, song, "expected no song")
+
+		err = dbsong.UpdateByID(ctx, 2, &data.Song{Title: "Updated Test Song", Duration: 4 * time.Minute})
+		assert.NoError(t, err, "unexpected error when updating a song")
+
+		err = dbsong.DeleteByID(ctx, 2)
+		assert.NoError(t, err, "unexpected error when deleting a song")
+
+		err = dbsong.DeleteByID(ctx, 3)
+		assert.Error(t, err, "expected an error when deleting a missing song")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	dbsong := NewSongDB(db)
+func TestListSongs(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		expectedSongs := []*data.Song{
+			{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
+			{ID: 2, Title: "Song 2", Duration: 4 * time.Minute},
+		}
+
+		mock.ExpectQuery("SELECT (.+) FROM songs ORDER BY ordinal, id").
+			WillReturnRows(songRows(expectedSongs...))
+
+		songs, err := dbsong.List(ctx)
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Equal(t, expectedSongs, songs, "expected songs list to match")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	ctx := context.Background()
-	song := &data.Song{
-		ID:       1,
-		Title:    "Test Song",
-		Duration: 4 * time.Minute,
-	}
+func TestListSongPage(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		expectedSongs := []*data.Song{
+			{ID: 3, Title: "Song 3", Duration: 2 * time.Minute},
+			{ID: 4, Title: "Song 4", Duration: 4 * time.Minute},
+		}
+
+		mock.ExpectQuery("SELECT (.+) FROM songs ORDER BY id ASC LIMIT \\$1").
+			WithArgs(3).
+			WillReturnRows(songRows(expectedSongs...))
+
+		songs, err := dbsong.ListPage(ctx, data.SongListOptions{Limit: 3})
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Equal(t, expectedSongs, songs, "expected songs list to match")
+
+		// the filters and the keyset of the previous page are combined
+		mock.ExpectQuery("SELECT (.+) FROM songs WHERE duration >= \\$1 AND duration <= \\$2 AND title LIKE \\$3 ESCAPE '\\\\' "+
+			"AND \\(duration, id\\) < \\(\\$4, \\$5\\) ORDER BY duration DESC, id DESC LIMIT \\$6").
+			WithArgs(int64(60), int64(300), `50\%%`, int64(240), 4, 2).
+			WillReturnRows(songRows(expectedSongs[0]))
+
+		songs, err = dbsong.ListPage(ctx, data.SongListOptions{
+			Limit:       2,
+			OrderBy:     data.SongOrderDuration,
+			Descending:  true,
+			After:       expectedSongs[1],
+			MinDuration: time.Minute,
+			MaxDuration: 5 * time.Minute,
+			TitlePrefix: "50%",
+		})
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Equal(t, expectedSongs[:1], songs, "expected songs list to match")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
 
-	mock.ExpectExec("UPDATE songs SET title = \\$2, duration = \\$3 WHERE title = \\$1").
-		WithArgs(song.Title, "Updated Test Song", song.Duration.Seconds()).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+// searchQueries are the queries a dialect searches with and their
+// arguments for the searches of TestSearchSongs.
+type searchQueries struct {
+	ranked  string
+	similar string
+	// prefixes match "Bohem' Rhap!", misspelled and similar match "bohemain"
+	prefixes    string
+	misspelled  string
+	similarArgs string
+}
 
-	dbsong.Create(ctx, song)
-	err = dbsong.Update(ctx, song.Title, "Updated Test Song", 4*time.Minute)
-	assert.NoError(t, err, "unexpected error when updating a song")
+var searches = map[dialect]searchQueries{
+	postgresDialect{}: {
+		ranked:      "SELECT (.+) FROM songs WHERE search @@ to_tsquery\\('simple', \\$1\\) ORDER BY ts_rank",
+		similar:     "SELECT (.+) FROM songs WHERE title % \\$1 OR artist % \\$1 OR album % \\$1 ORDER BY GREATEST",
+		prefixes:    "bohem:* & rhap:*",
+		misspelled:  "bohemain:*",
+		similarArgs: "bohemain",
+	},
+	sqliteDialect{}: {
+		ranked:      "SELECT (.+) FROM songs_search JOIN songs s ON s.id = songs_search.rowid WHERE songs_search MATCH \\$1 ORDER BY bm25",
+		similar:     "SELECT (.+) FROM songs_trigram JOIN songs s ON s.id = songs_trigram.rowid WHERE songs_trigram MATCH \\$1 ORDER BY bm25",
+		prefixes:    `"bohem"* "rhap"*`,
+		misspelled:  `"bohemain"*`,
+		similarArgs: `"boh" OR "ohe" OR "hem" OR "ema" OR "mai" OR "ain"`,
+	},
+}
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+func TestSearchSongs(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		queries := searches[dbsong.(*songSQL).dialect]
+		expectedSongs := []*data.Song{
+			{ID: 1, Title: "Bohemian Rhapsody", Duration: 6 * time.Minute, Artist: "Queen"},
+		}
+
+		// every word is a prefix and the punctuation is dropped
+		mock.ExpectQuery(queries.ranked).
+			WithArgs(queries.prefixes, 10).
+			WillReturnRows(songRows(expectedSongs...))
+
+		songs, err := dbsong.Search(ctx, "Bohem' Rhap!", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Equal(t, expectedSongs, songs, "expected songs list to match")
+
+		// the similar songs are returned when nothing matches
+		mock.ExpectQuery(queries.ranked).
+			WithArgs(queries.misspelled, 10).
+			WillReturnRows(songRows())
+		mock.ExpectQuery(queries.similar).
+			WithArgs(queries.similarArgs, 10).
+			WillReturnRows(songRows(expectedSongs...))
+
+		songs, err = dbsong.Search(ctx, " bohemain ", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Equal(t, expectedSongs, songs, "expected songs list to match")
+
+		// a query without words finds nothing
+		songs, err = dbsong.Search(ctx, "?!", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Empty(t, songs, "expected no songs")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func TestDeleteSong(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	defer db.Close()
+func TestGetPlayerState(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+
+		columns := []string{"song_id", "position", "repeat_mode", "shuffle", "shuffle_seed"}
 
-	dbsong := NewSongDB(db)
+		mock.ExpectQuery("SELECT song_id, position, repeat_mode, shuffle, shuffle_seed FROM player_state WHERE id = 1").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 30, 2, true, 42))
 
-	ctx := context.Background()
-	songTitle := "song"
+		state, err := dbsong.GetPlayerState(ctx)
+		assert.NoError(t, err, "unexpected error when getting the player state")
+		expectedState := &data.PlayerState{SongID: 2, Position: 30 * time.Second, RepeatMode: 2, Shuffle: true, ShuffleSeed: 42}
+		assert.Equal(t, expectedState, state, "expected player state to match")
 
-	mock.ExpectExec("DELETE FROM songs WHERE title = \\$1").
-		WithArgs(songTitle).
-		WillReturnResult(sqlmock.NewResult(0, 1)) // Одна строка удалена.
+		mock.ExpectQuery("SELECT song_id, position, repeat_mode, shuffle, shuffle_seed FROM player_state WHERE id = 1").
+			WillReturnRows(sqlmock.NewRows(columns))
 
-	err = dbsong.Delete(ctx, songTitle)
-	assert.NoError(t, err, "unexpected error when deleting a song")
+		state, err = dbsong.GetPlayerState(ctx)
+		assert.NoError(t, err, "unexpected error when getting the missing player state")
+		assert.Nil(t, state, "expected no player state")
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
-func TestListSongs(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	assert.NoError(t, err)
-	defer db.Close()
+func TestSavePlayerState(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
+		state := &data.PlayerState{SongID: 2, Position: 30 * time.Second, RepeatMode: 1}
 
-	dbsong := NewSongDB(db)
+		mock.ExpectExec("INSERT INTO player_state").
+			WithArgs(int64(2), int64(30), 1, false, int64(0)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
 
-	ctx := context.Background()
-	expectedSongs := []*data.Song{
-		{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
-		{ID: 2, Title: "Song 2", Duration: 4 * time.Minute},
-	}
+		err := dbsong.SavePlayerState(ctx, state)
+		assert.NoError(t, err, "unexpected error when saving the player state")
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSetOrder(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, mock sqlmock.Sqlmock, dbsong SongDB) {
+		ctx := context.Background()
 
-	mock.ExpectQuery("SELECT id, title, duration FROM songs").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "duration"}).
-			AddRow(expectedSongs[0].ID, expectedSongs[0].Title, int64(expectedSongs[0].Duration.Seconds())).
-			AddRow(expectedSongs[1].ID, expectedSongs[1].Title, int64(expectedSongs[1].Duration.Seconds())))
+		mock.ExpectBegin()
+		mock.ExpectExec("UPDATE songs SET ordinal = \\$2 WHERE id = \\$1").
+			WithArgs(3, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE songs SET ordinal = \\$2 WHERE id = \\$1").
+			WithArgs(1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 
-	songs, err := dbsong.List(ctx)
-	assert.NoError(t, err, "unexpected error when listing songs")
-	assert.Equal(t, expectedSongs, songs, "expected songs list to match")
+		err := dbsong.SetOrder(ctx, []int{3, 1})
+		assert.NoError(t, err, "unexpected error when setting the order")
 
-	assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
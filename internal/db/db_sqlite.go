@@ -0,0 +1,135 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// sqliteTimeLayout is the layout of the times stored by SQLite, the same as
+// strftime('%Y-%m-%d %H:%M:%f') in UTC.
+const sqliteTimeLayout = "2006-01-02 15:04:05.000"
+
+// sqlitePragmas are set on every connection: the playlists lose the deleted
+// songs by the foreign keys, LIKE matches the case as in PostgreSQL and a
+// locked database is waited for instead of failing at once.
+var sqlitePragmas = []string{"foreign_keys(1)", "case_sensitive_like(1)", "busy_timeout(5000)"}
+
+// OpenSQLite opens the SQLite database of the DSN, a file name or a file:
+// URI, with the pragmas NewSQLiteSongDB relies on. SQLite writes one
+// transaction at a time, so the database is used over one connection.
+func OpenSQLite(dsn string) (*sql.DB, error) {
+	separator := "?"
+	if strings.Contains(dsn, "?") {
+		separator = "&"
+	}
+	for _, pragma := range sqlitePragmas {
+		dsn += separator + "_pragma=" + pragma
+		separator = "&"
+	}
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// NewSQLiteSongDB returns the SongDB stored in SQLite. The database must be
+// opened by OpenSQLite and migrated with migrations/sqlite.
+func NewSQLiteSongDB(db *sql.DB) SongDB {
+	return &songSQL{db: db, q: db, dialect: sqliteDialect{}}
+}
+
+type sqliteDialect struct{}
+
+// search matches the FTS5 index of the songs and falls back to the trigram
+// index, which finds the songs sharing the most trigrams with the query.
+func (sqliteDialect) search(ctx context.Context, q queryer, query string, limit int) ([]*data.Song, error) {
+	words := searchWords(query)
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	// the weights of the columns are the ones ts_rank gives to A, B and C
+	ranked := `
+		SELECT s.id, s.title, s.duration, s.artist, s.album, s.album_artist, s.track_number, s.disc_number, s.genre, s.year, s.tags, s.created_at
+		FROM songs_search
+		JOIN songs s ON s.id = songs_search.rowid
+		WHERE songs_search MATCH $1
+		ORDER BY bm25(songs_search, 1.0, 0.4, 0.2), s.id
+		LIMIT $2
+	`
+
+	songs, err := querySongs(ctx, q, ranked, prefixMatch(words), limit)
+	if err != nil || len(songs) > 0 {
+		return songs, err
+	}
+
+	trigrams := trigramMatch(words)
+	if trigrams == "" {
+		return nil, nil
+	}
+
+	similar := `
+		SELECT s.id, s.title, s.duration, s.artist, s.album, s.album_artist, s.track_number, s.disc_number, s.genre, s.year, s.tags, s.created_at
+		FROM songs_trigram
+		JOIN songs s ON s.id = songs_trigram.rowid
+		WHERE songs_trigram MATCH $1
+		ORDER BY bm25(songs_trigram), s.id
+		LIMIT $2
+	`
+
+	return querySongs(ctx, q, similar, trigrams, limit)
+}
+
+func (sqliteDialect) timeArg(t time.Time) any {
+	return t.UTC().Format(sqliteTimeLayout)
+}
+
+func (sqliteDialect) constraintError(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch sqliteErr.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return ErrorUniqueViolation
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return ErrorForeignKeyViolation
+	}
+	return err
+}
+
+// prefixMatch turns the words into an FTS5 query where every word is a
+// prefix, "bohem rhap" becomes `"bohem"* "rhap"*`.
+func prefixMatch(words []string) string {
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, fmt.Sprintf(`"%s"*`, word))
+	}
+	return strings.Join(terms, " ")
+}
+
+// trigramMatch returns the FTS5 query matching any trigram of the words,
+// "queen" becomes `"que" OR "uee" OR "een"`. The words shorter than three
+// letters have no trigrams.
+func trigramMatch(words []string) string {
+	var terms []string
+	for _, word := range words {
+		runes := []rune(word)
+		for i := 0; i+3 <= len(runes); i++ {
+			terms = append(terms, fmt.Sprintf(`"%s"`, string(runes[i:i+3])))
+		}
+	}
+	return strings.Join(terms, " OR ")
+}
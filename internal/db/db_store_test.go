@@ -0,0 +1,261 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"MusicPlayerProject/internal/migrate"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSQLiteDB returns the SongDB of a new SQLite database migrated with the
+// embedded migrations.
+func newSQLiteDB(t *testing.T) SongDB {
+	db, err := OpenSQLite(filepath.Join(t.TempDir(), "playlist.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	provider, err := migrate.NewProvider("sqlite", db)
+	assert.NoError(t, err)
+	_, err = provider.Up(context.Background())
+	assert.NoError(t, err, "unexpected error when migrating the database")
+
+	return NewSQLiteSongDB(db)
+}
+
+// stores are the SongDB backends the behavior tests run against.
+var stores = []struct {
+	name string
+	open func(t *testing.T) SongDB
+}{
+	{"sqlite", newSQLiteDB},
+	{"memory", func(t *testing.T) SongDB { return NewMemorySongDB() }},
+}
+
+// forEachStore runs the test against a new SongDB of every store.
+func forEachStore(t *testing.T, test func(t *testing.T, dbsong SongDB)) {
+	for _, store := range stores {
+		t.Run(store.name, func(t *testing.T) {
+			test(t, store.open(t))
+		})
+	}
+}
+
+func TestStoreSongs(t *testing.T) {
+	forEachStore(t, func(t *testing.T, dbsong SongDB) {
+		ctx := context.Background()
+
+		song := &data.Song{
+			Title:    "Song 1",
+			Duration: 3 * time.Minute,
+			Artist:   "Artist",
+			Album:    "Album",
+			Year:     1999,
+			Tags:     map[string]string{"mood": "calm"},
+		}
+		id1, err := dbsong.Create(ctx, song)
+		assert.NoError(t, err, "unexpected error when creating a song")
+		id2, err := dbsong.Create(ctx, &data.Song{Title: "song 2", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+
+		_, err = dbsong.Create(ctx, song)
+		assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation when creating the same song twice")
+
+		got, err := dbsong.Find(ctx, "Artist", "Song 1", "Album")
+		assert.NoError(t, err, "unexpected error when finding a song")
+		assert.Equal(t, id1, got.ID, "expected the created song")
+		assert.Equal(t, song.Tags, got.Tags, "expected the tags to be kept")
+		assert.Equal(t, 3*time.Minute, got.Duration, "expected the duration to be kept")
+		assert.False(t, got.CreatedAt.IsZero(), "expected the creation time to be set")
+
+		err = dbsong.UpdateByID(ctx, id2, &data.Song{Title: "Song 2", Duration: 2 * time.Minute})
+		assert.NoError(t, err, "unexpected error when updating a song")
+		err = dbsong.SetOrder(ctx, []int{id2, id1})
+		assert.NoError(t, err, "unexpected error when setting the order")
+
+		songs, err := dbsong.List(ctx)
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Len(t, songs, 2, "expected two songs")
+		assert.Equal(t, []int{id2, id1}, []int{songs[0].ID, songs[1].ID}, "expected the stored order")
+
+		// the title prefix is matched with its case, as in PostgreSQL
+		page, err := dbsong.ListPage(ctx, data.SongListOptions{Limit: 10, TitlePrefix: "song"})
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Empty(t, page, "expected no songs for the prefix of another case")
+
+		page, err = dbsong.ListPage(ctx, data.SongListOptions{Limit: 1, OrderBy: data.SongOrderCreatedAt, Descending: true})
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Equal(t, id2, page[0].ID, "expected the newest song first")
+
+		page, err = dbsong.ListPage(ctx, data.SongListOptions{Limit: 1, OrderBy: data.SongOrderCreatedAt, Descending: true, After: page[0]})
+		assert.NoError(t, err, "unexpected error when listing songs")
+		assert.Equal(t, id1, page[0].ID, "expected the next page to continue after the song")
+
+		err = dbsong.Delete(ctx, "Song 1")
+		assert.NoError(t, err, "unexpected error when deleting a song")
+		err = dbsong.DeleteByID(ctx, id1)
+		assert.Error(t, err, "expected an error when deleting a missing song")
+	})
+}
+
+func TestStoreUpdate(t *testing.T) {
+	forEachStore(t, func(t *testing.T, dbsong SongDB) {
+		ctx := context.Background()
+
+		id, err := dbsong.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute, Artist: "Artist", Tags: map[string]string{"mood": "calm"}})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		created, err := dbsong.GetByID(ctx, id)
+		assert.NoError(t, err, "unexpected error when getting a song")
+
+		song := &data.Song{
+			Title:       "Song 2",
+			Duration:    2 * time.Minute,
+			Artist:      "Other Artist",
+			Album:       "Album",
+			AlbumArtist: "Various Artists",
+			TrackNumber: 3,
+			DiscNumber:  2,
+			Genre:       "Jazz",
+			Year:        1959,
+			Tags:        map[string]string{"label": "Columbia"},
+		}
+		err = dbsong.Update(ctx, "Song 1", song)
+		assert.NoError(t, err, "unexpected error when updating a song")
+
+		got, err := dbsong.GetByID(ctx, id)
+		assert.NoError(t, err, "unexpected error when getting a song")
+		song.ID = id
+		song.CreatedAt = created.CreatedAt
+		assert.Equal(t, song, got, "expected the title, the duration and the metadata to be stored")
+
+		// the cleared metadata is stored too
+		err = dbsong.UpdateByID(ctx, id, &data.Song{Title: "Song 2", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when updating a song")
+		got, err = dbsong.GetByID(ctx, id)
+		assert.NoError(t, err, "unexpected error when getting a song")
+		assert.Equal(t, &data.Song{ID: id, Title: "Song 2", Duration: time.Minute, CreatedAt: created.CreatedAt}, got, "expected the metadata to be cleared")
+
+		// the new artist, title and album must stay unique
+		_, err = dbsong.Create(ctx, &data.Song{Title: "Song 3", Duration: time.Minute, Artist: "Artist"})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		err = dbsong.UpdateByID(ctx, id, &data.Song{Title: "Song 3", Duration: time.Minute, Artist: "Artist"})
+		assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation, but got: %v", err)
+	})
+}
+
+func TestStoreSearch(t *testing.T) {
+	forEachStore(t, func(t *testing.T, dbsong SongDB) {
+		ctx := context.Background()
+
+		rhapsody, err := dbsong.Create(ctx, &data.Song{Title: "Bohemian Rhapsody", Duration: 6 * time.Minute, Artist: "Queen"})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		queen, err := dbsong.Create(ctx, &data.Song{Title: "Other Song", Duration: time.Minute, Album: "Queen Live"})
+		assert.NoError(t, err, "unexpected error when creating a song")
+
+		songs, err := dbsong.Search(ctx, "Bohem' Rhap!", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Len(t, songs, 1, "expected one song")
+		assert.Equal(t, rhapsody, songs[0].ID, "expected the song with the title prefixes")
+
+		// the artist weighs more than the album
+		songs, err = dbsong.Search(ctx, "queen", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Len(t, songs, 2, "expected two songs")
+		assert.Equal(t, []int{rhapsody, queen}, []int{songs[0].ID, songs[1].ID}, "expected the artist match first")
+
+		// a misspelled word finds the similar songs
+		songs, err = dbsong.Search(ctx, "bohemain", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.NotEmpty(t, songs, "expected the similar songs")
+		assert.Equal(t, rhapsody, songs[0].ID, "expected the most similar song first")
+
+		// the index follows the updates and the deletions
+		err = dbsong.UpdateByID(ctx, queen, &data.Song{Title: "Radio Ga Ga", Duration: time.Minute, Album: "Queen Live"})
+		assert.NoError(t, err, "unexpected error when updating a song")
+		songs, err = dbsong.Search(ctx, "radio", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Len(t, songs, 1, "expected the updated title to be found")
+
+		err = dbsong.DeleteByID(ctx, rhapsody)
+		assert.NoError(t, err, "unexpected error when deleting a song")
+		songs, err = dbsong.Search(ctx, "rhapsody", 10)
+		assert.NoError(t, err, "unexpected error when searching songs")
+		assert.Empty(t, songs, "expected the deleted song not to be found")
+	})
+}
+
+func TestStorePlaylists(t *testing.T) {
+	forEachStore(t, func(t *testing.T, dbsong SongDB) {
+		ctx := context.Background()
+
+		id1, err := dbsong.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		id2, err := dbsong.Create(ctx, &data.Song{Title: "Song 2", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+
+		playlistID, err := dbsong.CreatePlaylist(ctx, "Playlist 1")
+		assert.NoError(t, err, "unexpected error when creating a playlist")
+		_, err = dbsong.CreatePlaylist(ctx, "Playlist 1")
+		assert.ErrorIs(t, err, ErrorUniqueViolation, "expected ErrorUniqueViolation when creating the same playlist twice")
+		err = dbsong.AddPlaylistSong(ctx, playlistID, id2+10)
+		assert.ErrorIs(t, err, ErrorForeignKeyViolation, "expected ErrorForeignKeyViolation when adding an unknown song")
+
+		for _, id := range []int{id1, id2, id1} {
+			assert.NoError(t, dbsong.AddPlaylistSong(ctx, playlistID, id), "unexpected error when adding a song")
+		}
+		assert.NoError(t, dbsong.RemovePlaylistSong(ctx, playlistID, id1), "unexpected error when removing a song")
+
+		songs, err := dbsong.ListPlaylistSongs(ctx, playlistID)
+		assert.NoError(t, err, "unexpected error when listing the playlist songs")
+		assert.Equal(t, []int{id2, id1}, []int{songs[0].ID, songs[1].ID}, "expected the first entry to be removed")
+
+		err = dbsong.SavePlayerState(ctx, &data.PlayerState{SongID: id1, Position: 30 * time.Second, Shuffle: true})
+		assert.NoError(t, err, "unexpected error when saving the player state")
+
+		// the deleted song leaves the playlists and the player state
+		assert.NoError(t, dbsong.DeleteByID(ctx, id1), "unexpected error when deleting a song")
+
+		songs, err = dbsong.ListPlaylistSongs(ctx, playlistID)
+		assert.NoError(t, err, "unexpected error when listing the playlist songs")
+		assert.Len(t, songs, 1, "expected the entries of the deleted song to be removed")
+
+		state, err := dbsong.GetPlayerState(ctx)
+		assert.NoError(t, err, "unexpected error when getting the player state")
+		assert.Equal(t, &data.PlayerState{Position: 30 * time.Second, Shuffle: true}, state, "expected the song to be unset")
+
+		assert.NoError(t, dbsong.DeletePlaylist(ctx, playlistID), "unexpected error when deleting a playlist")
+		p, err := dbsong.GetPlaylist(ctx, playlistID)
+		assert.NoError(t, err, "unexpected error when getting a playlist")
+		assert.Nil(t, p, "expected no playlist")
+	})
+}
+
+func TestStoreTx(t *testing.T) {
+	forEachStore(t, func(t *testing.T, dbsong SongDB) {
+		ctx := context.Background()
+
+		tx, err := dbsong.BeginTx(ctx)
+		assert.NoError(t, err, "unexpected error when beginning a transaction")
+		id, err := tx.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		assert.NoError(t, tx.SetOrder(ctx, []int{id}), "unexpected error when setting the order")
+		assert.NoError(t, tx.Rollback(), "unexpected error when rolling back")
+
+		song, err := dbsong.GetByID(ctx, id)
+		assert.NoError(t, err, "unexpected error when getting a song")
+		assert.Nil(t, song, "expected the song to be rolled back")
+
+		tx, err = dbsong.BeginTx(ctx)
+		assert.NoError(t, err, "unexpected error when beginning a transaction")
+		id, err = tx.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+		assert.NoError(t, err, "unexpected error when creating a song")
+		assert.NoError(t, tx.Commit(), "unexpected error when committing")
+
+		song, err = dbsong.GetByID(ctx, id)
+		assert.NoError(t, err, "unexpected error when getting a song")
+		assert.NotNil(t, song, "expected the committed song")
+	})
+}
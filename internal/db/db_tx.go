@@ -0,0 +1,69 @@
+package db_song
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// SongTx is a SongDB whose operations run in one database transaction.
+// Nothing is visible to the others until Commit; after Commit or Rollback
+// the SongTx must not be used.
+type SongTx interface {
+	SongDB
+	Commit() error
+	Rollback() error
+}
+
+var ErrorNestedTx = errors.New("The transaction is already started")
+
+// queryer is a *sql.DB or a *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type songTx struct {
+	*songSQL
+}
+
+func (r *songSQL) BeginTx(ctx context.Context) (SongTx, error) {
+	if r.tx != nil {
+		return nil, ErrorNestedTx
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &songTx{&songSQL{db: r.db, q: tx, tx: tx, dialect: r.dialect}}, nil
+}
+
+func (t *songTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *songTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// withTx runs fn in the transaction of the SongTx or, outside of one, in a
+// new transaction committed when fn succeeds.
+func (r *songSQL) withTx(ctx context.Context, fn func(tx queryer) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(tx)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
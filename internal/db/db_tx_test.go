@@ -0,0 +1,57 @@
+package db_song
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBeginTx(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	dbsong := NewSongDB(db)
+
+	ctx := context.Background()
+
+	// the operations of the transaction and the nested SetOrder run in one
+	// transaction
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO songs").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec("UPDATE songs SET ordinal = \\$2 WHERE id = \\$1").
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	tx, err := dbsong.BeginTx(ctx)
+	assert.NoError(t, err, "unexpected error when beginning a transaction")
+
+	_, err = tx.BeginTx(ctx)
+	assert.ErrorIs(t, err, ErrorNestedTx, "expected ErrorNestedTx for a nested transaction")
+
+	id, err := tx.Create(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+	assert.NoError(t, err, "unexpected error when creating a song")
+	err = tx.SetOrder(ctx, []int{id})
+	assert.NoError(t, err, "unexpected error when setting the order")
+	assert.NoError(t, tx.Commit(), "unexpected error when committing")
+
+	// nothing is kept when the transaction rolls back
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM songs").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	tx, err = dbsong.BeginTx(ctx)
+	assert.NoError(t, err, "unexpected error when beginning a transaction")
+	assert.NoError(t, tx.DeleteByID(ctx, 1), "unexpected error when deleting a song")
+	assert.NoError(t, tx.Rollback(), "unexpected error when rolling back")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
+	"MusicPlayerProject/internal/usecase"
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo details attached to the errors.
+const errorDomain = "playlist"
+
+type errorMapping struct {
+	err    error
+	code   codes.Code
+	reason string
+}
+
+// errorMappings translates the domain errors into status codes and the
+// machine-readable reasons clients can switch on. The errors are matched
+// with errors.Is, so wrapped errors are translated too.
+var errorMappings = []errorMapping{
+	{playlist.ErrorEmptyTitleSong, codes.InvalidArgument, "EMPTY_TITLE"},
+	{playlist.ErrorNotValidDurationSong, codes.InvalidArgument, "INVALID_DURATION"},
+	{playlist.ErrorNotValidRepeatMode, codes.InvalidArgument, "INVALID_REPEAT_MODE"},
+	{playlist.ErrorNotValidIndex, codes.OutOfRange, "INDEX_OUT_OF_RANGE"},
+	{playlist.ErrorNotFoundSong, codes.NotFound, "SONG_NOT_FOUND"},
+	{playlist.ErrorEmptyPlaylist, codes.FailedPrecondition, "EMPTY_PLAYLIST"},
+	{playlist.ErrorPlayingPlaylist, codes.FailedPrecondition, "ALREADY_PLAYING"},
+	{playlist.ErrorNotPlayingPlaylist, codes.FailedPrecondition, "NOT_PLAYING"},
+	{playlist.ErrorPausedPlaylist, codes.FailedPrecondition, "ALREADY_PAUSED"},
+	{playlist.ErrorPlayingSong, codes.FailedPrecondition, "SONG_PLAYING"},
+
+	{usecase.ErrorEmptyPlaylistName, codes.InvalidArgument, "EMPTY_PLAYLIST_NAME"},
+	{usecase.ErrorNotFoundSongOnBase, codes.NotFound, "SONG_NOT_FOUND"},
+	{usecase.ErrorNotFoundPlaylist, codes.NotFound, "PLAYLIST_NOT_FOUND"},
+	{usecase.ErrorSongExised, codes.AlreadyExists, "SONG_EXISTS"},
+	{usecase.ErrorPlaylistExists, codes.AlreadyExists, "PLAYLIST_EXISTS"},
+	{usecase.ErrorPlayingInPlaylist, codes.FailedPrecondition, "SONG_PLAYING"},
+	{usecase.ErrorNilPlaylist, codes.Internal, "PLAYLIST_NOT_LOADED"},
+	{usecase.ErrorLibraryOrder, codes.InvalidArgument, "LIBRARY_ORDER"},
+	{usecase.ErrorNotValidPageSize, codes.InvalidArgument, "INVALID_PAGE_SIZE"},
+	{usecase.ErrorInvalidPageToken, codes.InvalidArgument, "INVALID_PAGE_TOKEN"},
+	{usecase.ErrorNotValidSongOrder, codes.InvalidArgument, "INVALID_SONG_ORDER"},
+	{usecase.ErrorEmptySearchQuery, codes.InvalidArgument, "EMPTY_SEARCH_QUERY"},
+
+	{db_song.ErrorUniqueViolation, codes.AlreadyExists, "ALREADY_EXISTS"},
+	// the song or the playlist has been deleted while it was referenced
+	{db_song.ErrorForeignKeyViolation, codes.NotFound, "NOT_FOUND"},
+
+	{context.Canceled, codes.Canceled, "CANCELED"},
+	{context.DeadlineExceeded, codes.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+}
+
+// internalMessage is the message of the unknown errors. Their text may tell
+// about the database, so it is only logged.
+const internalMessage = "internal error"
+
+// toStatus translates the error returned by a handler into a status error.
+// Errors which already carry a status are returned as they are, unknown
+// errors become Internal.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var seekErr *playlist.SeekOutOfRangeError
+	if errors.As(err, &seekErr) {
+		return newStatus(codes.OutOfRange, "SEEK_OUT_OF_RANGE", err)
+	}
+
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return newStatus(m.code, m.reason, err)
+		}
+	}
+
+	slog.Error("Unexpected error", "error", err)
+	return newStatus(codes.Internal, "INTERNAL", errors.New(internalMessage))
+}
+
+func newStatus(code codes.Code, reason string, err error) error {
+	st := status.New(code, err.Error())
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: errorDomain,
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// UnaryErrorInterceptor translates the errors of the unary handlers into
+// status errors with meaningful codes.
+func UnaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// StreamErrorInterceptor does the same for the streaming handlers.
+func StreamErrorInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(handler(srv, stream))
+}
@@ -0,0 +1,90 @@
+package grpcserver
+
+import (
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
+	"MusicPlayerProject/internal/usecase"
+	pb "MusicPlayerProject/proto"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reason returns the reason of the ErrorInfo attached to the status error.
+func reason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{playlist.ErrorNotFoundSong, codes.NotFound, "SONG_NOT_FOUND"},
+		{usecase.ErrorNotFoundPlaylist, codes.NotFound, "PLAYLIST_NOT_FOUND"},
+		{usecase.ErrorSongExised, codes.AlreadyExists, "SONG_EXISTS"},
+		{playlist.ErrorEmptyTitleSong, codes.InvalidArgument, "EMPTY_TITLE"},
+		{usecase.ErrorLibraryOrder, codes.InvalidArgument, "LIBRARY_ORDER"},
+		{playlist.ErrorNotPlayingPlaylist, codes.FailedPrecondition, "NOT_PLAYING"},
+		{fmt.Errorf("%w (playlist 1)", usecase.ErrorPlayingInPlaylist), codes.FailedPrecondition, "SONG_PLAYING"},
+		{playlist.ErrorNotValidIndex, codes.OutOfRange, "INDEX_OUT_OF_RANGE"},
+		{&playlist.SeekOutOfRangeError{Offset: time.Hour, Duration: time.Minute}, codes.OutOfRange, "SEEK_OUT_OF_RANGE"},
+		{context.DeadlineExceeded, codes.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+		{fmt.Errorf("create: %w", db_song.ErrorUniqueViolation), codes.AlreadyExists, "ALREADY_EXISTS"},
+		{fmt.Errorf("add: %w", db_song.ErrorForeignKeyViolation), codes.NotFound, "NOT_FOUND"},
+	}
+
+	for _, tt := range tests {
+		err := toStatus(tt.err)
+		assert.Equal(t, tt.code, status.Code(err), "unexpected code for %v", tt.err)
+		assert.Equal(t, tt.reason, reason(err), "unexpected reason for %v", tt.err)
+		assert.Equal(t, tt.err.Error(), status.Convert(err).Message(), "expected the message of %v", tt.err)
+	}
+
+	// the text of an unknown error is not sent to the client
+	err := toStatus(errors.New(`pq: relation "songs" does not exist`))
+	assert.Equal(t, codes.Internal, status.Code(err), "expected Internal for an unknown error")
+	assert.Equal(t, "INTERNAL", reason(err), "expected the reason of an unknown error")
+	assert.Equal(t, "internal error", status.Convert(err).Message(), "expected a generic message")
+
+	// status errors are passed as they are
+	err = status.Error(codes.Unavailable, "try later")
+	assert.Equal(t, err, toStatus(err), "expected the status error to be kept")
+	assert.NoError(t, toStatus(nil), "expected no error for nil")
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("DeleteSong", mock.Anything, "Song 1").Return(usecase.ErrorNotFoundSongOnBase)
+	mockController.On("WatchPlayback", mock.Anything, 5).Return((*playlist.Subscription)(nil), usecase.ErrorNotFoundPlaylist)
+
+	_, err = client.DeleteSong(context.Background(), &pb.DeleteSongRequest{Title: "Song 1"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown song")
+	assert.Equal(t, "SONG_NOT_FOUND", reason(err), "expected the reason of an unknown song")
+
+	stream, err := client.WatchPlayback(context.Background(), &pb.PlaybackRequest{PlaylistId: 5})
+	assert.NoError(t, err, "unexpected error during WatchPlayback gRPC call")
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown playlist")
+	assert.Equal(t, "PLAYLIST_NOT_FOUND", reason(err), "expected the reason of an unknown playlist")
+}
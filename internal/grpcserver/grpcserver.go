@@ -1,10 +1,16 @@
 package grpcserver
 
 import (
+	"MusicPlayerProject/internal/data"
+	"MusicPlayerProject/internal/playlist"
 	"MusicPlayerProject/internal/usecase"
 	pb "MusicPlayerProject/proto"
 	"context"
+	"math/rand"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCServer struct {
@@ -17,12 +23,25 @@ func NewGRPCServer(controller usecase.IPlaylistController) *GRPCServer {
 }
 
 func (s *GRPCServer) CreateSong(ctx context.Context, req *pb.CreateSongRequest) (*pb.SongResponse, error) {
-	id, err := s.controller.CreateSong(ctx, req.Title, time.Duration(req.Duration)*time.Second)
+	song := &data.Song{
+		Title:       req.Title,
+		Duration:    time.Duration(req.Duration) * time.Second,
+		Artist:      req.Artist,
+		Album:       req.Album,
+		AlbumArtist: req.AlbumArtist,
+		TrackNumber: int(req.TrackNumber),
+		DiscNumber:  int(req.DiscNumber),
+		Genre:       req.Genre,
+		Year:        int(req.Year),
+		Tags:        req.Tags,
+	}
+	id, err := s.controller.CreateSong(ctx, song)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.SongResponse{Id: int32(id), Title: req.Title, Duration: req.Duration}, nil
+	song.ID = id
+	return songResponse(song), nil
 }
 
 func (s *GRPCServer) GetSong(ctx context.Context, req *pb.GetSongRequest) (*pb.SongResponse, error) {
@@ -30,23 +49,32 @@ func (s *GRPCServer) GetSong(ctx context.Context, req *pb.GetSongRequest) (*pb.S
 	if err != nil {
 		return nil, err
 	}
-	return &pb.SongResponse{
-		Id:       int32(song.ID),
-		Title:    song.Title,
-		Duration: int64(song.Duration.Seconds()),
-	}, nil
+	return songResponse(song), nil
 }
 
 func (s *GRPCServer) UpdateSong(ctx context.Context, req *pb.UpdateSongRequest) (*pb.SongResponse, error) {
-	err := s.controller.UpdateSong(ctx, req.OldTitle, req.NewTitle, time.Duration(req.Duration)*time.Second)
+	id, err := s.controller.UpdateSong(ctx, req.OldTitle, &data.Song{
+		Title:       req.NewTitle,
+		Duration:    time.Duration(req.Duration) * time.Second,
+		Artist:      req.Artist,
+		Album:       req.Album,
+		AlbumArtist: req.AlbumArtist,
+		TrackNumber: int(req.TrackNumber),
+		DiscNumber:  int(req.DiscNumber),
+		Genre:       req.Genre,
+		Year:        int(req.Year),
+		Tags:        req.Tags,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.SongResponse{
-		Title:    req.NewTitle,
-		Duration: req.Duration,
-	}, nil
+	// the response is the stored song with all its metadata
+	song, err := s.controller.GetSongByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return songResponse(song), nil
 }
 
 func (s *GRPCServer) DeleteSong(ctx context.Context, req *pb.DeleteSongRequest) (*pb.EmptyMessage, error) {
@@ -58,52 +86,414 @@ func (s *GRPCServer) DeleteSong(ctx context.Context, req *pb.DeleteSongRequest)
 	return &pb.EmptyMessage{}, nil
 }
 
-func (s *GRPCServer) ListSongs(ctx context.Context, req *pb.EmptyMessage) (*pb.ListSongsResponse, error) {
-	songs, err := s.controller.ListSongs(ctx)
+func (s *GRPCServer) GetSongByID(ctx context.Context, req *pb.SongIDRequest) (*pb.SongResponse, error) {
+	song, err := s.controller.GetSongByID(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return songResponse(song), nil
+}
+
+func (s *GRPCServer) UpdateSongByID(ctx context.Context, req *pb.UpdateSongByIDRequest) (*pb.SongResponse, error) {
+	err := s.controller.UpdateSongByID(ctx, int(req.Id), &data.Song{
+		Title:       req.Title,
+		Duration:    time.Duration(req.Duration) * time.Second,
+		Artist:      req.Artist,
+		Album:       req.Album,
+		AlbumArtist: req.AlbumArtist,
+		TrackNumber: int(req.TrackNumber),
+		DiscNumber:  int(req.DiscNumber),
+		Genre:       req.Genre,
+		Year:        int(req.Year),
+		Tags:        req.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := s.controller.GetSongByID(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return songResponse(song), nil
+}
+
+func (s *GRPCServer) DeleteSongByID(ctx context.Context, req *pb.SongIDRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.DeleteSongByID(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) ListSongs(ctx context.Context, req *pb.ListSongsRequest) (*pb.ListSongsResponse, error) {
+	page, err := s.controller.ListSongs(ctx, usecase.SongQuery{
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+		OrderBy:     data.SongOrder(req.OrderBy),
+		Descending:  req.Descending,
+		MinDuration: time.Duration(req.MinDuration) * time.Second,
+		MaxDuration: time.Duration(req.MaxDuration) * time.Second,
+		TitlePrefix: req.TitlePrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var songResponses []*pb.SongResponse
+	for _, song := range page.Songs {
+		songResponses = append(songResponses, songResponse(song))
+	}
+
+	return &pb.ListSongsResponse{Songs: songResponses, NextPageToken: page.NextPageToken}, nil
+}
+
+func (s *GRPCServer) SearchSongs(ctx context.Context, req *pb.SearchSongsRequest) (*pb.ListSongsResponse, error) {
+	songs, err := s.controller.SearchSongs(ctx, req.Query, int(req.Limit))
 	if err != nil {
 		return nil, err
 	}
 
 	var songResponses []*pb.SongResponse
 	for _, song := range songs {
-		songResponses = append(songResponses, &pb.SongResponse{
-			Id:       int32(song.ID),
-			Title:    song.Title,
-			Duration: int64(song.Duration.Seconds()),
-		})
+		songResponses = append(songResponses, songResponse(song))
 	}
 
 	return &pb.ListSongsResponse{Songs: songResponses}, nil
 }
 
-func (s *GRPCServer) Play(ctx context.Context, req *pb.EmptyMessage) (*pb.EmptyMessage, error) {
-	err := s.controller.PlaySong(ctx)
+func (s *GRPCServer) CreatePlaylist(ctx context.Context, req *pb.CreatePlaylistRequest) (*pb.PlaylistResponse, error) {
+	id, err := s.controller.CreatePlaylist(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PlaylistResponse{Id: int32(id), Name: req.Name}, nil
+}
+
+func (s *GRPCServer) ListPlaylists(ctx context.Context, req *pb.EmptyMessage) (*pb.ListPlaylistsResponse, error) {
+	playlists, err := s.controller.ListPlaylists(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	var playlistResponses []*pb.PlaylistResponse
+	for _, p := range playlists {
+		var songResponses []*pb.SongResponse
+		for _, song := range p.Songs {
+			songResponses = append(songResponses, songResponse(song))
+		}
+
+		playlistResponses = append(playlistResponses, &pb.PlaylistResponse{
+			Id:    int32(p.ID),
+			Name:  p.Name,
+			Songs: songResponses,
+		})
+	}
+
+	return &pb.ListPlaylistsResponse{Playlists: playlistResponses}, nil
+}
+
+func (s *GRPCServer) RenamePlaylist(ctx context.Context, req *pb.RenamePlaylistRequest) (*pb.PlaylistResponse, error) {
+	err := s.controller.RenamePlaylist(ctx, int(req.Id), req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PlaylistResponse{Id: req.Id, Name: req.Name}, nil
+}
+
+func (s *GRPCServer) DeletePlaylist(ctx context.Context, req *pb.DeletePlaylistRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.DeletePlaylist(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) AddSongToPlaylist(ctx context.Context, req *pb.PlaylistSongRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.AddSongToPlaylist(ctx, int(req.PlaylistId), req.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) RemoveSongFromPlaylist(ctx context.Context, req *pb.PlaylistSongRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.RemoveSongFromPlaylist(ctx, int(req.PlaylistId), req.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) InsertSong(ctx context.Context, req *pb.InsertSongRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.InsertSong(ctx, int(req.PlaylistId), req.Title, int(req.Index))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) MoveSong(ctx context.Context, req *pb.MoveSongRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.MoveSong(ctx, int(req.PlaylistId), req.Title, int(req.Index))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) SwapSongs(ctx context.Context, req *pb.SwapSongsRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.SwapSongs(ctx, int(req.PlaylistId), req.First, req.Second)
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.EmptyMessage{}, nil
 }
 
-func (s *GRPCServer) Pause(ctx context.Context, req *pb.EmptyMessage) (*pb.EmptyMessage, error) {
-	err := s.controller.PauseSong(ctx)
+func (s *GRPCServer) Play(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.PlaySong(ctx, int(req.PlaylistId))
 	if err != nil {
 		return nil, err
 	}
 	return &pb.EmptyMessage{}, nil
 }
 
-func (s *GRPCServer) Next(ctx context.Context, req *pb.EmptyMessage) (*pb.EmptyMessage, error) {
-	err := s.controller.NextSong(ctx)
+func (s *GRPCServer) Pause(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.PauseSong(ctx, int(req.PlaylistId))
 	if err != nil {
 		return nil, err
 	}
 	return &pb.EmptyMessage{}, nil
 }
 
-func (s *GRPCServer) Prev(ctx context.Context, req *pb.EmptyMessage) (*pb.EmptyMessage, error) {
-	err := s.controller.PrevSong(ctx)
+func (s *GRPCServer) Stop(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.StopSong(ctx, int(req.PlaylistId))
 	if err != nil {
 		return nil, err
 	}
 	return &pb.EmptyMessage{}, nil
 }
+
+func (s *GRPCServer) Next(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.NextSong(ctx, int(req.PlaylistId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) Prev(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.PrevSong(ctx, int(req.PlaylistId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) PlayAt(ctx context.Context, req *pb.PlayAtRequest) (*pb.EmptyMessage, error) {
+	var err error
+	switch song := req.Song.(type) {
+	case *pb.PlayAtRequest_Title:
+		err = s.controller.PlaySongByTitle(ctx, int(req.PlaylistId), song.Title)
+	case *pb.PlayAtRequest_Index:
+		err = s.controller.PlayAt(ctx, int(req.PlaylistId), int(song.Index))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either the title or the index of the song is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) Seek(ctx context.Context, req *pb.SeekRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.SeekSong(ctx, int(req.PlaylistId), time.Duration(req.Position)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+// SetShuffle passes the seed as it is, zero included, unless the client asks
+// for a random one.
+func (s *GRPCServer) SetShuffle(ctx context.Context, req *pb.SetShuffleRequest) (*pb.EmptyMessage, error) {
+	seed := req.Seed
+	if req.RandomSeed {
+		seed = rand.Int63()
+	}
+
+	err := s.controller.SetShuffle(ctx, int(req.PlaylistId), req.Enabled, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+var repeatModes = map[pb.RepeatMode]playlist.RepeatMode{
+	pb.RepeatMode_REPEAT_OFF: playlist.RepeatOff,
+	pb.RepeatMode_REPEAT_ALL: playlist.RepeatAll,
+	pb.RepeatMode_REPEAT_ONE: playlist.RepeatOne,
+}
+
+func (s *GRPCServer) SetRepeatMode(ctx context.Context, req *pb.SetRepeatModeRequest) (*pb.EmptyMessage, error) {
+	mode, ok := repeatModes[req.Mode]
+	if !ok {
+		return nil, playlist.ErrorNotValidRepeatMode
+	}
+
+	err := s.controller.SetRepeatMode(ctx, int(req.PlaylistId), mode)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) EnqueueNext(ctx context.Context, req *pb.PlaylistSongRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.EnqueueNext(ctx, int(req.PlaylistId), req.Title)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) ListQueue(ctx context.Context, req *pb.PlaybackRequest) (*pb.ListSongsResponse, error) {
+	songs, err := s.controller.ListQueue(ctx, int(req.PlaylistId))
+	if err != nil {
+		return nil, err
+	}
+
+	var songResponses []*pb.SongResponse
+	for _, song := range songs {
+		songResponses = append(songResponses, entryResponse(song))
+	}
+
+	return &pb.ListSongsResponse{Songs: songResponses}, nil
+}
+
+func (s *GRPCServer) ClearQueue(ctx context.Context, req *pb.PlaybackRequest) (*pb.EmptyMessage, error) {
+	err := s.controller.ClearQueue(ctx, int(req.PlaylistId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EmptyMessage{}, nil
+}
+
+func (s *GRPCServer) GetPlaybackState(ctx context.Context, req *pb.PlaybackRequest) (*pb.PlaybackStateResponse, error) {
+	state, err := s.controller.GetPlaybackState(ctx, int(req.PlaylistId))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PlaybackStateResponse{
+		Elapsed:     int64(state.Elapsed.Seconds()),
+		Remaining:   int64(state.Remaining.Seconds()),
+		Index:       int32(state.Index),
+		Shuffle:     state.Shuffle,
+		ShuffleSeed: state.ShuffleSeed,
+	}
+
+	switch state.Status {
+	case playlist.StatusPlaying:
+		resp.Status = pb.PlaybackStatus_PLAYING
+	case playlist.StatusPaused:
+		resp.Status = pb.PlaybackStatus_PAUSED
+	default:
+		resp.Status = pb.PlaybackStatus_STOPPED
+	}
+
+	for pbMode, mode := range repeatModes {
+		if mode == state.Repeat {
+			resp.Repeat = pbMode
+		}
+	}
+
+	if state.Song != nil {
+		resp.Song = entryResponse(*state.Song)
+	}
+
+	return resp, nil
+}
+
+var eventTypes = map[playlist.EventType]pb.PlaybackEventType{
+	playlist.EventSongStarted:  pb.PlaybackEventType_SONG_STARTED,
+	playlist.EventSongFinished: pb.PlaybackEventType_SONG_FINISHED,
+	playlist.EventPaused:       pb.PlaybackEventType_SONG_PAUSED,
+	playlist.EventResumed:      pb.PlaybackEventType_SONG_RESUMED,
+	playlist.EventSkipped:      pb.PlaybackEventType_SONG_SKIPPED,
+	playlist.EventSongAdded:    pb.PlaybackEventType_SONG_ADDED,
+	playlist.EventSongRemoved:  pb.PlaybackEventType_SONG_REMOVED,
+	playlist.EventSongUpdated:  pb.PlaybackEventType_SONG_UPDATED,
+	playlist.EventStopped:      pb.PlaybackEventType_SONG_STOPPED,
+	playlist.EventSongMoved:    pb.PlaybackEventType_SONG_MOVED,
+	playlist.EventSongQueued:   pb.PlaybackEventType_SONG_QUEUED,
+}
+
+func (s *GRPCServer) WatchPlayback(req *pb.PlaybackRequest, stream pb.PlaylistService_WatchPlaybackServer) error {
+	subscription, err := s.controller.WatchPlayback(stream.Context(), int(req.PlaylistId))
+	if err != nil {
+		return err
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return nil
+			}
+
+			err := stream.Send(&pb.PlaybackEvent{
+				Type:      eventTypes[event.Type],
+				Song:      entryResponse(event.Song),
+				Timestamp: event.Time.UnixMilli(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// songResponse converts a song of the library into its response.
+func songResponse(song *data.Song) *pb.SongResponse {
+	return &pb.SongResponse{
+		Id:          int32(song.ID),
+		Title:       song.Title,
+		Duration:    int64(song.Duration.Seconds()),
+		Artist:      song.Artist,
+		Album:       song.Album,
+		AlbumArtist: song.AlbumArtist,
+		TrackNumber: int32(song.TrackNumber),
+		DiscNumber:  int32(song.DiscNumber),
+		Genre:       song.Genre,
+		Year:        int32(song.Year),
+		Tags:        song.Tags,
+	}
+}
+
+// entryResponse converts an entry of a playlist into its response.
+func entryResponse(song playlist.Song) *pb.SongResponse {
+	return &pb.SongResponse{
+		Id:          int32(song.ID),
+		Title:       song.Title,
+		Duration:    int64(song.Duration.Seconds()),
+		Artist:      song.Artist,
+		Album:       song.Album,
+		AlbumArtist: song.AlbumArtist,
+		TrackNumber: int32(song.TrackNumber),
+		DiscNumber:  int32(song.DiscNumber),
+		Genre:       song.Genre,
+		Year:        int32(song.Year),
+		Tags:        song.Tags,
+	}
+}
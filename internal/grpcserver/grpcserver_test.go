@@ -2,6 +2,8 @@ package grpcserver
 
 import (
 	"MusicPlayerProject/internal/data"
+	"MusicPlayerProject/internal/playlist"
+	"MusicPlayerProject/internal/usecase"
 	pb "MusicPlayerProject/proto"
 	"context"
 	"net"
@@ -11,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
@@ -18,8 +22,8 @@ type MockPlaylistController struct {
 	mock.Mock
 }
 
-func (m *MockPlaylistController) CreateSong(ctx context.Context, title string, duration time.Duration) (int, error) {
-	args := m.Called(ctx, title, duration)
+func (m *MockPlaylistController) CreateSong(ctx context.Context, song *data.Song) (int, error) {
+	args := m.Called(ctx, song)
 	return args.Int(0), args.Error(1)
 }
 
@@ -28,9 +32,9 @@ func (m *MockPlaylistController) GetSong(ctx context.Context, title string) (*da
 	return args.Get(0).(*data.Song), args.Error(1)
 }
 
-func (m *MockPlaylistController) UpdateSong(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error {
-	args := m.Called(ctx, oldTitle, newTitle, duration)
-	return args.Error(0)
+func (m *MockPlaylistController) UpdateSong(ctx context.Context, oldTitle string, song *data.Song) (int, error) {
+	args := m.Called(ctx, oldTitle, song)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockPlaylistController) DeleteSong(ctx context.Context, title string) error {
@@ -38,36 +42,160 @@ func (m *MockPlaylistController) DeleteSong(ctx context.Context, title string) e
 	return args.Error(0)
 }
 
-func (m *MockPlaylistController) ListSongs(ctx context.Context) ([]*data.Song, error) {
-	args := m.Called(ctx)
+func (m *MockPlaylistController) GetSongByID(ctx context.Context, id int) (*data.Song, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*data.Song), args.Error(1)
+}
+
+func (m *MockPlaylistController) UpdateSongByID(ctx context.Context, id int, song *data.Song) error {
+	args := m.Called(ctx, id, song)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) DeleteSongByID(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) SearchSongs(ctx context.Context, query string, limit int) ([]*data.Song, error) {
+	args := m.Called(ctx, query, limit)
 	return args.Get(0).([]*data.Song), args.Error(1)
 }
 
-func (m *MockPlaylistController) PlaySong(ctx context.Context) error {
+func (m *MockPlaylistController) ListSongs(ctx context.Context, query usecase.SongQuery) (*usecase.SongPage, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).(*usecase.SongPage), args.Error(1)
+}
+
+func (m *MockPlaylistController) CreatePlaylist(ctx context.Context, name string) (int, error) {
+	args := m.Called(ctx, name)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPlaylistController) ListPlaylists(ctx context.Context) ([]*data.Playlist, error) {
 	args := m.Called(ctx)
+	return args.Get(0).([]*data.Playlist), args.Error(1)
+}
+
+func (m *MockPlaylistController) RenamePlaylist(ctx context.Context, id int, name string) error {
+	args := m.Called(ctx, id, name)
 	return args.Error(0)
 }
 
-func (m *MockPlaylistController) PauseSong(ctx context.Context) error {
-	args := m.Called(ctx)
+func (m *MockPlaylistController) DeletePlaylist(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockPlaylistController) NextSong(ctx context.Context) error {
-	args := m.Called(ctx)
+func (m *MockPlaylistController) AddSongToPlaylist(ctx context.Context, playlistID int, title string) error {
+	args := m.Called(ctx, playlistID, title)
 	return args.Error(0)
 }
 
-func (m *MockPlaylistController) PrevSong(ctx context.Context) error {
-	args := m.Called(ctx)
+func (m *MockPlaylistController) RemoveSongFromPlaylist(ctx context.Context, playlistID int, title string) error {
+	args := m.Called(ctx, playlistID, title)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) InsertSong(ctx context.Context, playlistID int, title string, index int) error {
+	args := m.Called(ctx, playlistID, title, index)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) MoveSong(ctx context.Context, playlistID int, title string, index int) error {
+	args := m.Called(ctx, playlistID, title, index)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) SwapSongs(ctx context.Context, playlistID int, first string, second string) error {
+	args := m.Called(ctx, playlistID, first, second)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) PlaySong(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) PauseSong(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) StopSong(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) NextSong(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) PrevSong(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) PlaySongByTitle(ctx context.Context, playlistID int, title string) error {
+	args := m.Called(ctx, playlistID, title)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) PlayAt(ctx context.Context, playlistID int, index int) error {
+	args := m.Called(ctx, playlistID, index)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) SeekSong(ctx context.Context, playlistID int, offset time.Duration) error {
+	args := m.Called(ctx, playlistID, offset)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) GetPlaybackState(ctx context.Context, playlistID int) (*playlist.PlaybackState, error) {
+	args := m.Called(ctx, playlistID)
+	return args.Get(0).(*playlist.PlaybackState), args.Error(1)
+}
+
+func (m *MockPlaylistController) WatchPlayback(ctx context.Context, playlistID int) (*playlist.Subscription, error) {
+	args := m.Called(ctx, playlistID)
+	return args.Get(0).(*playlist.Subscription), args.Error(1)
+}
+
+func (m *MockPlaylistController) SetShuffle(ctx context.Context, playlistID int, enabled bool, seed int64) error {
+	args := m.Called(ctx, playlistID, enabled, seed)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) SetRepeatMode(ctx context.Context, playlistID int, mode playlist.RepeatMode) error {
+	args := m.Called(ctx, playlistID, mode)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) EnqueueNext(ctx context.Context, playlistID int, title string) error {
+	args := m.Called(ctx, playlistID, title)
+	return args.Error(0)
+}
+
+func (m *MockPlaylistController) ListQueue(ctx context.Context, playlistID int) ([]playlist.Song, error) {
+	args := m.Called(ctx, playlistID)
+	return args.Get(0).([]playlist.Song), args.Error(1)
+}
+
+func (m *MockPlaylistController) ClearQueue(ctx context.Context, playlistID int) error {
+	args := m.Called(ctx, playlistID)
 	return args.Error(0)
 }
 
-func bufDialer(mockController *MockPlaylistController) (*grpc.ClientConn, func(), error) {
+// bufDialer serves the controller over an in-memory connection.
+func bufDialer(controller usecase.IPlaylistController) (*grpc.ClientConn, func(), error) {
 	const bufSize = 1024 * 1024
 	lis := bufconn.Listen(bufSize)
-	server := grpc.NewServer()
-	grpcServer := NewGRPCServer(mockController)
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryErrorInterceptor),
+		grpc.StreamInterceptor(StreamErrorInterceptor),
+	)
+	grpcServer := NewGRPCServer(controller)
 
 	pb.RegisterPlaylistServiceServer(server, grpcServer)
 
@@ -99,20 +227,35 @@ func TestCreateSong(t *testing.T) {
 	expectedID := 1
 	mockController.On("CreateSong",
 		mock.Anything,
-		"Test Song",
-		3*time.Minute,
+		&data.Song{
+			Title:       "Test Song",
+			Duration:    3 * time.Minute,
+			Artist:      "Artist",
+			Album:       "Album",
+			TrackNumber: 2,
+			Year:        2001,
+			Tags:        map[string]string{"mood": "calm"},
+		},
 	).Return(expectedID, nil)
 
 	req := &pb.CreateSongRequest{
-		Title:    "Test Song",
-		Duration: int64(3 * time.Minute.Seconds()),
+		Title:       "Test Song",
+		Duration:    int64(3 * time.Minute.Seconds()),
+		Artist:      "Artist",
+		Album:       "Album",
+		TrackNumber: 2,
+		Year:        2001,
+		Tags:        map[string]string{"mood": "calm"},
 	}
 
 	resp, err := client.CreateSong(context.Background(), req)
 
 	assert.NoError(t, err, "unexpected error during CreateSong gRPC call")
 	assert.Equal(t, expectedID, int(resp.Id), "expected song ID to match")
-	mockController.AssertCalled(t, "CreateSong", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, "Artist", resp.Artist, "expected artist to match")
+	assert.Equal(t, int32(2001), resp.Year, "expected year to match")
+	assert.Equal(t, "calm", resp.Tags["mood"], "expected tags to match")
+	mockController.AssertCalled(t, "CreateSong", mock.Anything, mock.Anything)
 }
 func TestGetSong(t *testing.T) {
 	mockController := new(MockPlaylistController)
@@ -152,9 +295,23 @@ func TestListSongs(t *testing.T) {
 		{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
 		{ID: 2, Title: "Song 2", Duration: 4 * time.Minute},
 	}
-	mockController.On("ListSongs", mock.Anything).Return(expectedSongs, nil)
-
-	req := &pb.EmptyMessage{}
+	mockController.On("ListSongs", mock.Anything, usecase.SongQuery{
+		PageSize:    2,
+		PageToken:   "token",
+		OrderBy:     data.SongOrderTitle,
+		Descending:  true,
+		MinDuration: time.Minute,
+		TitlePrefix: "Song",
+	}).Return(&usecase.SongPage{Songs: expectedSongs, NextPageToken: "next"}, nil)
+
+	req := &pb.ListSongsRequest{
+		PageSize:    2,
+		PageToken:   "token",
+		OrderBy:     pb.SongOrder_SONG_ORDER_TITLE,
+		Descending:  true,
+		MinDuration: 60,
+		TitlePrefix: "Song",
+	}
 
 	resp, err := client.ListSongs(context.Background(), req)
 
@@ -162,8 +319,32 @@ func TestListSongs(t *testing.T) {
 	assert.Len(t, resp.Songs, 2, "expected two songs in the list")
 	assert.Equal(t, "Song 1", resp.Songs[0].Title, "expected Song 1 to match")
 	assert.Equal(t, "Song 2", resp.Songs[1].Title, "expected Song 2 to match")
+	assert.Equal(t, "next", resp.NextPageToken, "expected the token of the next page")
 
-	mockController.AssertCalled(t, "ListSongs", mock.Anything)
+	mockController.AssertCalled(t, "ListSongs", mock.Anything, mock.Anything)
+}
+
+func TestSearchSongs(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	expectedSongs := []*data.Song{
+		{ID: 1, Title: "Bohemian Rhapsody", Duration: 6 * time.Minute, Artist: "Queen"},
+	}
+	mockController.On("SearchSongs", mock.Anything, "bohem", 5).Return(expectedSongs, nil)
+	mockController.On("SearchSongs", mock.Anything, "", 0).Return([]*data.Song(nil), usecase.ErrorEmptySearchQuery)
+
+	resp, err := client.SearchSongs(context.Background(), &pb.SearchSongsRequest{Query: "bohem", Limit: 5})
+	assert.NoError(t, err, "unexpected error during SearchSongs gRPC call")
+	assert.Len(t, resp.Songs, 1, "expected one song in the list")
+	assert.Equal(t, "Queen", resp.Songs[0].Artist, "expected the artist to match")
+
+	_, err = client.SearchSongs(context.Background(), &pb.SearchSongsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected InvalidArgument for an empty query")
 }
 
 func TestDeleteSong(t *testing.T) {
@@ -192,20 +373,226 @@ func TestUpdateSong(t *testing.T) {
 
 	client := pb.NewPlaylistServiceClient(conn)
 
-	mockController.On("UpdateSong", mock.Anything, "Old Title", "Updated Title", 240*time.Second).
-		Return(nil)
+	updated := &data.Song{
+		Title:    "Updated Title",
+		Duration: 4 * time.Minute,
+		Artist:   "Artist",
+		Album:    "Album",
+		Year:     1999,
+		Tags:     map[string]string{"mood": "calm"},
+	}
+	mockController.On("UpdateSong", mock.Anything, "Old Title", updated).Return(7, nil)
+	mockController.On("GetSongByID", mock.Anything, 7).Return(&data.Song{
+		ID:       7,
+		Title:    "Updated Title",
+		Duration: 4 * time.Minute,
+		Artist:   "Artist",
+		Album:    "Album",
+	}, nil)
 
 	req := &pb.UpdateSongRequest{
 		OldTitle: "Old Title",
 		NewTitle: "Updated Title",
 		Duration: int64(4 * time.Minute.Seconds()),
+		Artist:   "Artist",
+		Album:    "Album",
+		Year:     1999,
+		Tags:     map[string]string{"mood": "calm"},
 	}
 
 	resp, err := client.UpdateSong(context.Background(), req)
 	assert.NoError(t, err, "unexpected error during UpdateSong gRPC call")
 	assert.Equal(t, "Updated Title", resp.Title, "expected the response Title to match")
+	assert.Equal(t, int32(7), resp.Id, "expected the ID of the stored song")
+	assert.Equal(t, "Artist", resp.Artist, "expected the metadata of the stored song")
+	assert.Equal(t, "Album", resp.Album, "expected the metadata of the stored song")
+
+	mockController.AssertCalled(t, "UpdateSong", mock.Anything, "Old Title", updated)
+}
+
+func TestSongByID(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	expectedSong := &data.Song{
+		ID:       2,
+		Title:    "Test Song",
+		Duration: 3 * time.Minute,
+	}
+	updatedSong := &data.Song{
+		ID:       2,
+		Title:    "Updated Title",
+		Duration: 4 * time.Minute,
+		Artist:   "Artist",
+	}
+	mockController.On("GetSongByID", mock.Anything, 2).Return(expectedSong, nil).Once()
+	mockController.On("UpdateSongByID", mock.Anything, 2, &data.Song{Title: "Updated Title", Duration: 4 * time.Minute, Artist: "Artist"}).Return(nil)
+	mockController.On("GetSongByID", mock.Anything, 2).Return(updatedSong, nil).Once()
+	mockController.On("DeleteSongByID", mock.Anything, 2).Return(nil)
+
+	resp, err := client.GetSongByID(context.Background(), &pb.SongIDRequest{Id: 2})
+	assert.NoError(t, err, "unexpected error during GetSongByID gRPC call")
+	assert.Equal(t, int32(2), resp.Id, "expected song ID to match")
+	assert.Equal(t, expectedSong.Title, resp.Title, "expected song Title to match")
+	assert.Equal(t, int64(180), resp.Duration, "expected song Duration to match")
+
+	resp, err = client.UpdateSongByID(context.Background(), &pb.UpdateSongByIDRequest{Id: 2, Title: "Updated Title", Duration: 240, Artist: "Artist"})
+	assert.NoError(t, err, "unexpected error during UpdateSongByID gRPC call")
+	assert.Equal(t, int32(2), resp.Id, "expected song ID to match")
+	assert.Equal(t, "Updated Title", resp.Title, "expected the response Title to match")
+	assert.Equal(t, "Artist", resp.Artist, "expected the metadata of the stored song")
+
+	_, err = client.DeleteSongByID(context.Background(), &pb.SongIDRequest{Id: 2})
+	assert.NoError(t, err, "unexpected error during DeleteSongByID gRPC call")
+
+	mockController.AssertExpectations(t)
+}
+
+func TestCreatePlaylist(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("CreatePlaylist", mock.Anything, "Favourites").Return(3, nil)
+
+	resp, err := client.CreatePlaylist(context.Background(), &pb.CreatePlaylistRequest{Name: "Favourites"})
+	assert.NoError(t, err, "unexpected error during CreatePlaylist gRPC call")
+	assert.Equal(t, int32(3), resp.Id, "expected playlist ID to match")
+	assert.Equal(t, "Favourites", resp.Name, "expected playlist Name to match")
+}
+
+func TestListPlaylists(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	playlists := []*data.Playlist{
+		{ID: 1, Name: "Favourites", Songs: []*data.Song{{ID: 2, Title: "Song 2", Duration: 2 * time.Minute}}},
+		{ID: 2, Name: "Empty"},
+	}
+	mockController.On("ListPlaylists", mock.Anything).Return(playlists, nil)
+
+	resp, err := client.ListPlaylists(context.Background(), &pb.EmptyMessage{})
+	assert.NoError(t, err, "unexpected error during ListPlaylists gRPC call")
+	assert.Len(t, resp.Playlists, 2, "expected two playlists in the list")
+	assert.Equal(t, "Favourites", resp.Playlists[0].Name, "expected playlist Name to match")
+	assert.Equal(t, "Song 2", resp.Playlists[0].Songs[0].Title, "expected song Title to match")
+	assert.Empty(t, resp.Playlists[1].Songs, "expected the second playlist to be empty")
+}
+
+func TestRenamePlaylist(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("RenamePlaylist", mock.Anything, 1, "Road trip").Return(nil)
 
-	mockController.AssertCalled(t, "UpdateSong", mock.Anything, "Old Title", "Updated Title", 240*time.Second)
+	resp, err := client.RenamePlaylist(context.Background(), &pb.RenamePlaylistRequest{Id: 1, Name: "Road trip"})
+	assert.NoError(t, err, "unexpected error during RenamePlaylist gRPC call")
+	assert.Equal(t, "Road trip", resp.Name, "expected playlist Name to match")
+}
+
+func TestDeletePlaylist(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("DeletePlaylist", mock.Anything, 1).Return(nil)
+
+	_, err = client.DeletePlaylist(context.Background(), &pb.DeletePlaylistRequest{Id: 1})
+	assert.NoError(t, err, "unexpected error during DeletePlaylist gRPC call")
+
+	mockController.AssertCalled(t, "DeletePlaylist", mock.Anything, 1)
+}
+
+func TestPlaylistSongs(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("AddSongToPlaylist", mock.Anything, 1, "Song 2").Return(nil)
+	mockController.On("RemoveSongFromPlaylist", mock.Anything, 1, "Song 2").Return(nil)
+
+	req := &pb.PlaylistSongRequest{PlaylistId: 1, Title: "Song 2"}
+
+	_, err = client.AddSongToPlaylist(context.Background(), req)
+	assert.NoError(t, err, "unexpected error during AddSongToPlaylist gRPC call")
+
+	_, err = client.RemoveSongFromPlaylist(context.Background(), req)
+	assert.NoError(t, err, "unexpected error during RemoveSongFromPlaylist gRPC call")
+
+	mockController.AssertCalled(t, "AddSongToPlaylist", mock.Anything, 1, "Song 2")
+	mockController.AssertCalled(t, "RemoveSongFromPlaylist", mock.Anything, 1, "Song 2")
+}
+
+func TestReorderSongs(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("InsertSong", mock.Anything, 1, "Song 1", 2).Return(nil)
+	mockController.On("MoveSong", mock.Anything, 1, "Song 2", 0).Return(nil)
+	mockController.On("SwapSongs", mock.Anything, 1, "Song 2", "Song 3").Return(nil)
+
+	_, err = client.InsertSong(context.Background(), &pb.InsertSongRequest{PlaylistId: 1, Title: "Song 1", Index: 2})
+	assert.NoError(t, err, "unexpected error during InsertSong gRPC call")
+
+	_, err = client.MoveSong(context.Background(), &pb.MoveSongRequest{PlaylistId: 1, Title: "Song 2", Index: 0})
+	assert.NoError(t, err, "unexpected error during MoveSong gRPC call")
+
+	_, err = client.SwapSongs(context.Background(), &pb.SwapSongsRequest{PlaylistId: 1, First: "Song 2", Second: "Song 3"})
+	assert.NoError(t, err, "unexpected error during SwapSongs gRPC call")
+
+	mockController.AssertExpectations(t)
+}
+
+func TestQueue(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("EnqueueNext", mock.Anything, 1, "Song 1").Return(nil)
+	mockController.On("ListQueue", mock.Anything, 1).Return([]playlist.Song{{Title: "Song 1", Duration: 3 * time.Minute}}, nil)
+	mockController.On("ClearQueue", mock.Anything, 1).Return(nil)
+
+	_, err = client.EnqueueNext(context.Background(), &pb.PlaylistSongRequest{PlaylistId: 1, Title: "Song 1"})
+	assert.NoError(t, err, "unexpected error during EnqueueNext gRPC call")
+
+	resp, err := client.ListQueue(context.Background(), &pb.PlaybackRequest{PlaylistId: 1})
+	assert.NoError(t, err, "unexpected error during ListQueue gRPC call")
+	assert.Len(t, resp.Songs, 1, "expected one song in the queue")
+	assert.Equal(t, "Song 1", resp.Songs[0].Title, "expected 'Song 1' in the queue")
+	assert.Equal(t, int64(180), resp.Songs[0].Duration, "expected the duration in seconds")
+
+	_, err = client.ClearQueue(context.Background(), &pb.PlaybackRequest{PlaylistId: 1})
+	assert.NoError(t, err, "unexpected error during ClearQueue gRPC call")
+
+	mockController.AssertExpectations(t)
 }
 
 func TestPlay(t *testing.T) {
@@ -216,12 +603,12 @@ func TestPlay(t *testing.T) {
 
 	client := pb.NewPlaylistServiceClient(conn)
 
-	mockController.On("PlaySong", mock.Anything).Return(nil)
+	mockController.On("PlaySong", mock.Anything, 0).Return(nil)
 
-	_, err = client.Play(context.Background(), &pb.EmptyMessage{})
+	_, err = client.Play(context.Background(), &pb.PlaybackRequest{})
 	assert.NoError(t, err, "unexpected error during Play gRPC call")
 
-	mockController.AssertCalled(t, "PlaySong", mock.Anything)
+	mockController.AssertCalled(t, "PlaySong", mock.Anything, 0)
 }
 
 func TestPause(t *testing.T) {
@@ -232,12 +619,12 @@ func TestPause(t *testing.T) {
 
 	client := pb.NewPlaylistServiceClient(conn)
 
-	mockController.On("PauseSong", mock.Anything).Return(nil)
+	mockController.On("PauseSong", mock.Anything, 0).Return(nil)
 
-	_, err = client.Pause(context.Background(), &pb.EmptyMessage{})
+	_, err = client.Pause(context.Background(), &pb.PlaybackRequest{})
 	assert.NoError(t, err, "unexpected error during Pause gRPC call")
 
-	mockController.AssertCalled(t, "PauseSong", mock.Anything)
+	mockController.AssertCalled(t, "PauseSong", mock.Anything, 0)
 }
 
 func TestNext(t *testing.T) {
@@ -248,12 +635,12 @@ func TestNext(t *testing.T) {
 
 	client := pb.NewPlaylistServiceClient(conn)
 
-	mockController.On("NextSong", mock.Anything).Return(nil)
+	mockController.On("NextSong", mock.Anything, 0).Return(nil)
 
-	_, err = client.Next(context.Background(), &pb.EmptyMessage{})
+	_, err = client.Next(context.Background(), &pb.PlaybackRequest{})
 	assert.NoError(t, err, "unexpected error during Next gRPC call")
 
-	mockController.AssertCalled(t, "NextSong", mock.Anything)
+	mockController.AssertCalled(t, "NextSong", mock.Anything, 0)
 }
 
 func TestPrev(t *testing.T) {
@@ -264,11 +651,184 @@ func TestPrev(t *testing.T) {
 
 	client := pb.NewPlaylistServiceClient(conn)
 
-	mockController.On("PrevSong", mock.Anything).
+	mockController.On("PrevSong", mock.Anything, 0).
 		Return(nil)
 
-	_, err = client.Prev(context.Background(), &pb.EmptyMessage{})
+	_, err = client.Prev(context.Background(), &pb.PlaybackRequest{})
 	assert.NoError(t, err, "unexpected error during Prev gRPC call")
 
-	mockController.AssertCalled(t, "PrevSong", mock.Anything)
+	mockController.AssertCalled(t, "PrevSong", mock.Anything, 0)
+}
+
+func TestPlayAt(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("PlaySongByTitle", mock.Anything, 1, "Song 2").Return(nil)
+	mockController.On("PlaySongByTitle", mock.Anything, 0, "Song 5").Return(playlist.ErrorNotFoundSong)
+	mockController.On("PlayAt", mock.Anything, 0, 2).Return(nil)
+	mockController.On("PlayAt", mock.Anything, 0, 5).Return(playlist.ErrorNotValidIndex)
+
+	_, err = client.PlayAt(context.Background(), &pb.PlayAtRequest{PlaylistId: 1, Song: &pb.PlayAtRequest_Title{Title: "Song 2"}})
+	assert.NoError(t, err, "unexpected error during PlayAt gRPC call")
+
+	_, err = client.PlayAt(context.Background(), &pb.PlayAtRequest{Song: &pb.PlayAtRequest_Index{Index: 2}})
+	assert.NoError(t, err, "unexpected error during PlayAt gRPC call")
+
+	_, err = client.PlayAt(context.Background(), &pb.PlayAtRequest{Song: &pb.PlayAtRequest_Title{Title: "Song 5"}})
+	assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown song")
+
+	_, err = client.PlayAt(context.Background(), &pb.PlayAtRequest{Song: &pb.PlayAtRequest_Index{Index: 5}})
+	assert.Equal(t, codes.OutOfRange, status.Code(err), "expected OutOfRange for an index past the end")
+	assert.Equal(t, "INDEX_OUT_OF_RANGE", reason(err), "expected the reason of an index past the end")
+
+	_, err = client.PlayAt(context.Background(), &pb.PlayAtRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected InvalidArgument without a song")
+
+	mockController.AssertExpectations(t)
+}
+
+func TestStop(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("StopSong", mock.Anything, 0).
+		Return(nil)
+
+	_, err = client.Stop(context.Background(), &pb.PlaybackRequest{})
+	assert.NoError(t, err, "unexpected error during Stop gRPC call")
+
+	mockController.AssertCalled(t, "StopSong", mock.Anything, 0)
+}
+
+func TestSeek(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("SeekSong", mock.Anything, 2, 30*time.Second).
+		Return(nil)
+
+	_, err = client.Seek(context.Background(), &pb.SeekRequest{Position: 30, PlaylistId: 2})
+	assert.NoError(t, err, "unexpected error during Seek gRPC call")
+
+	mockController.AssertCalled(t, "SeekSong", mock.Anything, 2, 30*time.Second)
+}
+
+func TestSetShuffle(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("SetShuffle", mock.Anything, 0, true, int64(42)).
+		Return(nil)
+
+	_, err = client.SetShuffle(context.Background(), &pb.SetShuffleRequest{Enabled: true, Seed: 42})
+	assert.NoError(t, err, "unexpected error during SetShuffle gRPC call")
+
+	mockController.AssertCalled(t, "SetShuffle", mock.Anything, 0, true, int64(42))
+
+	// zero is a seed like any other
+	mockController.On("SetShuffle", mock.Anything, 0, true, int64(0)).Return(nil).Once()
+	_, err = client.SetShuffle(context.Background(), &pb.SetShuffleRequest{Enabled: true})
+	assert.NoError(t, err, "unexpected error during SetShuffle gRPC call")
+	mockController.AssertCalled(t, "SetShuffle", mock.Anything, 0, true, int64(0))
+
+	// the server picks the seed when asked to
+	mockController.On("SetShuffle", mock.Anything, 0, true, mock.MatchedBy(func(seed int64) bool { return seed != 42 })).Return(nil).Once()
+	_, err = client.SetShuffle(context.Background(), &pb.SetShuffleRequest{Enabled: true, Seed: 42, RandomSeed: true})
+	assert.NoError(t, err, "unexpected error during SetShuffle gRPC call")
+	mockController.AssertNumberOfCalls(t, "SetShuffle", 3)
+}
+
+func TestSetRepeatMode(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	mockController.On("SetRepeatMode", mock.Anything, 0, playlist.RepeatOne).
+		Return(nil)
+
+	_, err = client.SetRepeatMode(context.Background(), &pb.SetRepeatModeRequest{Mode: pb.RepeatMode_REPEAT_ONE})
+	assert.NoError(t, err, "unexpected error during SetRepeatMode gRPC call")
+
+	_, err = client.SetRepeatMode(context.Background(), &pb.SetRepeatModeRequest{Mode: pb.RepeatMode(10)})
+	assert.Error(t, err, "expected error for unknown repeat mode")
+
+	mockController.AssertNumberOfCalls(t, "SetRepeatMode", 1)
+}
+
+func TestGetPlaybackState(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	state := &playlist.PlaybackState{
+		Song:        &playlist.Song{ID: 4, Title: "Song 2", Duration: 3 * time.Minute},
+		Index:       1,
+		Elapsed:     time.Minute,
+		Remaining:   2 * time.Minute,
+		Status:      playlist.StatusPaused,
+		Shuffle:     true,
+		ShuffleSeed: 7,
+		Repeat:      playlist.RepeatOne,
+	}
+	mockController.On("GetPlaybackState", mock.Anything, 0).Return(state, nil)
+
+	resp, err := client.GetPlaybackState(context.Background(), &pb.PlaybackRequest{})
+	assert.NoError(t, err, "unexpected error during GetPlaybackState gRPC call")
+	assert.Equal(t, "Song 2", resp.Song.Title, "expected song Title to match")
+	assert.Equal(t, int32(4), resp.Song.Id, "expected song ID to match")
+	assert.Equal(t, int32(1), resp.Index, "expected index to match")
+	assert.Equal(t, int64(60), resp.Elapsed, "expected elapsed to match")
+	assert.Equal(t, int64(120), resp.Remaining, "expected remaining to match")
+	assert.Equal(t, pb.PlaybackStatus_PAUSED, resp.Status, "expected status to match")
+	assert.Equal(t, pb.RepeatMode_REPEAT_ONE, resp.Repeat, "expected repeat mode to match")
+	assert.Equal(t, int64(7), resp.ShuffleSeed, "expected shuffle seed to match")
+}
+
+func TestWatchPlayback(t *testing.T) {
+	mockController := new(MockPlaylistController)
+	conn, cleanup, err := bufDialer(mockController)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	client := pb.NewPlaylistServiceClient(conn)
+
+	player := playlist.NewPlaylist()
+	subscription := player.Subscribe(10, playlist.DropOldest)
+	mockController.On("WatchPlayback", mock.Anything, 0).Return(subscription, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchPlayback(ctx, &pb.PlaybackRequest{})
+	assert.NoError(t, err, "unexpected error during WatchPlayback gRPC call")
+
+	player.AddSong("Song 1", 3*time.Minute)
+
+	event, err := stream.Recv()
+	assert.NoError(t, err, "unexpected error while receiving an event")
+	assert.Equal(t, pb.PlaybackEventType_SONG_ADDED, event.Type, "expected SONG_ADDED event")
+	assert.Equal(t, "Song 1", event.Song.Title, "expected song Title to match")
 }
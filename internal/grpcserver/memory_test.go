@@ -0,0 +1,174 @@
+package grpcserver
+
+import (
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/usecase"
+	pb "MusicPlayerProject/proto"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newMemoryClient serves a controller over a new in-memory SongDB, the way
+// the server runs with -storage=memory.
+func newMemoryClient(t *testing.T) pb.PlaylistServiceClient {
+	controller, err := usecase.NewPlaylistController(context.Background(), db_song.NewMemorySongDB())
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	conn, cleanup, err := bufDialer(controller)
+	assert.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	return pb.NewPlaylistServiceClient(conn)
+}
+
+// createSongs adds the songs of a minute each to the library.
+func createSongs(t *testing.T, client pb.PlaylistServiceClient, titles ...string) []int32 {
+	var ids []int32
+	for _, title := range titles {
+		resp, err := client.CreateSong(context.Background(), &pb.CreateSongRequest{Title: title, Duration: 60})
+		assert.NoError(t, err, "unexpected error during CreateSong gRPC call")
+		ids = append(ids, resp.GetId())
+	}
+	return ids
+}
+
+func songTitles(songs []*pb.SongResponse) []string {
+	var titles []string
+	for _, song := range songs {
+		titles = append(titles, song.Title)
+	}
+	return titles
+}
+
+func TestMemoryServer(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient)
+	}{
+		{"songs", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			ids := createSongs(t, client, "Song 1", "Song 2")
+
+			resp, err := client.GetSongByID(ctx, &pb.SongIDRequest{Id: ids[1]})
+			assert.NoError(t, err, "unexpected error during GetSongByID gRPC call")
+			assert.Equal(t, "Song 2", resp.Title, "expected the created song")
+
+			resp, err = client.UpdateSongByID(ctx, &pb.UpdateSongByIDRequest{Id: ids[1], Title: "Song 22", Duration: 90, Genre: "Rock", Year: 1975})
+			assert.NoError(t, err, "unexpected error during UpdateSongByID gRPC call")
+			assert.Equal(t, "Rock", resp.Genre, "expected the updated metadata")
+			assert.Equal(t, int32(1975), resp.Year, "expected the updated metadata")
+
+			list, err := client.ListSongs(ctx, &pb.ListSongsRequest{OrderBy: pb.SongOrder_SONG_ORDER_TITLE, Descending: true})
+			assert.NoError(t, err, "unexpected error during ListSongs gRPC call")
+			assert.Equal(t, []string{"Song 22", "Song 1"}, songTitles(list.Songs), "expected the songs by title")
+		}},
+		{"duplicate song", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			createSongs(t, client, "Song 1")
+
+			_, err := client.CreateSong(ctx, &pb.CreateSongRequest{Title: "Song 1", Duration: 60})
+			assert.Equal(t, codes.AlreadyExists, status.Code(err), "expected AlreadyExists for the same song")
+			assert.Equal(t, "SONG_EXISTS", reason(err), "expected the reason of the same song")
+		}},
+		{"unknown song", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			_, err := client.GetSongByID(ctx, &pb.SongIDRequest{Id: 7})
+			assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown song")
+
+			_, err = client.DeleteSong(ctx, &pb.DeleteSongRequest{Title: "Song 7"})
+			assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown song")
+		}},
+		{"search", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			createSongs(t, client, "Bohemian Rhapsody", "Under Pressure")
+
+			resp, err := client.SearchSongs(ctx, &pb.SearchSongsRequest{Query: "bohem rhap"})
+			assert.NoError(t, err, "unexpected error during SearchSongs gRPC call")
+			assert.Equal(t, []string{"Bohemian Rhapsody"}, songTitles(resp.Songs), "expected the matching song")
+		}},
+		{"playlists", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			createSongs(t, client, "Song 1", "Song 2", "Song 3")
+
+			p, err := client.CreatePlaylist(ctx, &pb.CreatePlaylistRequest{Name: "Playlist 1"})
+			assert.NoError(t, err, "unexpected error during CreatePlaylist gRPC call")
+			for _, title := range []string{"Song 1", "Song 2", "Song 3"} {
+				_, err = client.AddSongToPlaylist(ctx, &pb.PlaylistSongRequest{PlaylistId: p.Id, Title: title})
+				assert.NoError(t, err, "unexpected error during AddSongToPlaylist gRPC call")
+			}
+			_, err = client.MoveSong(ctx, &pb.MoveSongRequest{PlaylistId: p.Id, Title: "Song 3", Index: 0})
+			assert.NoError(t, err, "unexpected error during MoveSong gRPC call")
+			_, err = client.RemoveSongFromPlaylist(ctx, &pb.PlaylistSongRequest{PlaylistId: p.Id, Title: "Song 1"})
+			assert.NoError(t, err, "unexpected error during RemoveSongFromPlaylist gRPC call")
+
+			_, err = client.CreatePlaylist(ctx, &pb.CreatePlaylistRequest{Name: "Playlist 1"})
+			assert.Equal(t, codes.AlreadyExists, status.Code(err), "expected AlreadyExists for the same name")
+
+			resp, err := client.ListPlaylists(ctx, &pb.EmptyMessage{})
+			assert.NoError(t, err, "unexpected error during ListPlaylists gRPC call")
+			assert.Len(t, resp.Playlists, 1, "expected the created playlist")
+			assert.Equal(t, []string{"Song 3", "Song 2"}, songTitles(resp.Playlists[0].Songs), "expected the stored order")
+		}},
+		{"library order", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			createSongs(t, client, "Song 1", "Song 2")
+
+			// the library playlist is rejected the same way by every reordering
+			calls := map[string]func() error{
+				"InsertSong": func() error {
+					_, err := client.InsertSong(ctx, &pb.InsertSongRequest{Title: "Song 1", Index: 0})
+					return err
+				},
+				"MoveSong": func() error {
+					_, err := client.MoveSong(ctx, &pb.MoveSongRequest{Title: "Song 2", Index: 0})
+					return err
+				},
+				"SwapSongs": func() error {
+					_, err := client.SwapSongs(ctx, &pb.SwapSongsRequest{First: "Song 1", Second: "Song 2"})
+					return err
+				},
+			}
+			for name, call := range calls {
+				err := call()
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "expected InvalidArgument from %s", name)
+				assert.Equal(t, "LIBRARY_ORDER", reason(err), "expected the reason of the library order from %s", name)
+			}
+
+			resp, err := client.ListSongs(ctx, &pb.ListSongsRequest{})
+			assert.NoError(t, err, "unexpected error during ListSongs gRPC call")
+			assert.Equal(t, []string{"Song 1", "Song 2"}, songTitles(resp.Songs), "expected the order of creation")
+		}},
+		{"playback", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			createSongs(t, client, "Song 1", "Song 2")
+
+			_, err := client.Play(ctx, &pb.PlaybackRequest{})
+			assert.NoError(t, err, "unexpected error during Play gRPC call")
+			_, err = client.Next(ctx, &pb.PlaybackRequest{})
+			assert.NoError(t, err, "unexpected error during Next gRPC call")
+
+			state, err := client.GetPlaybackState(ctx, &pb.PlaybackRequest{})
+			assert.NoError(t, err, "unexpected error during GetPlaybackState gRPC call")
+			assert.Equal(t, pb.PlaybackStatus_PLAYING, state.Status, "expected the player to be playing")
+			assert.Equal(t, "Song 2", state.Song.Title, "expected 'Song 2' to be playing")
+
+			// the playing song cannot be deleted
+			_, err = client.DeleteSong(ctx, &pb.DeleteSongRequest{Title: "Song 2"})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err), "expected FailedPrecondition for the playing song")
+			assert.Equal(t, "SONG_PLAYING", reason(err), "expected the reason of the playing song")
+
+			_, err = client.Stop(ctx, &pb.PlaybackRequest{})
+			assert.NoError(t, err, "unexpected error during Stop gRPC call")
+			_, err = client.DeleteSong(ctx, &pb.DeleteSongRequest{Title: "Song 2"})
+			assert.NoError(t, err, "unexpected error during DeleteSong gRPC call")
+		}},
+		{"unknown playlist", func(t *testing.T, ctx context.Context, client pb.PlaylistServiceClient) {
+			_, err := client.Play(ctx, &pb.PlaybackRequest{PlaylistId: 5})
+			assert.Equal(t, codes.NotFound, status.Code(err), "expected NotFound for an unknown playlist")
+			assert.Equal(t, "PLAYLIST_NOT_FOUND", reason(err), "expected the reason of an unknown playlist")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, context.Background(), newMemoryClient(t))
+		})
+	}
+}
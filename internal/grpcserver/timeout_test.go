@@ -0,0 +1,31 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryTimeoutInterceptor(t *testing.T) {
+	var deadline time.Time
+	var limited bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		deadline, limited = ctx.Deadline()
+		return req, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/playlist.PlaylistService/ListSongs"}
+
+	start := time.Now()
+	resp, err := UnaryTimeoutInterceptor(time.Minute)(context.Background(), "request", info, handler)
+	assert.NoError(t, err, "unexpected error from the interceptor")
+	assert.Equal(t, "request", resp, "expected the response of the handler")
+	assert.True(t, limited, "expected the call to have a deadline")
+	assert.WithinDuration(t, start.Add(time.Minute), deadline, time.Second, "expected the deadline of the timeout")
+
+	_, err = UnaryTimeoutInterceptor(0)(context.Background(), "request", info, handler)
+	assert.NoError(t, err, "unexpected error from the interceptor")
+	assert.False(t, limited, "expected no deadline for a zero timeout")
+}
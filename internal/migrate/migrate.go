@@ -0,0 +1,134 @@
+// Package migrate runs the embedded goose migrations of the SQL storages.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+
+	"MusicPlayerProject/migrations"
+
+	"github.com/pressly/goose/v3"
+)
+
+// Mode is what the server does with the migrations at startup.
+type Mode string
+
+const (
+	// ModeUp applies the pending migrations.
+	ModeUp Mode = "up"
+	// ModeCheck refuses to start while a migration is pending.
+	ModeCheck Mode = "check"
+	// ModeOff leaves the database as it is.
+	ModeOff Mode = "off"
+)
+
+var (
+	ErrorUnknownMode       = errors.New("The migration mode must be up, check or off")
+	ErrorUnknownStorage    = errors.New("The storage has no migrations")
+	ErrorPendingMigrations = errors.New("The database has pending migrations")
+)
+
+func ParseMode(mode string) (Mode, error) {
+	switch Mode(mode) {
+	case ModeUp, ModeCheck, ModeOff:
+		return Mode(mode), nil
+	default:
+		return "", ErrorUnknownMode
+	}
+}
+
+// NewProvider returns the goose provider of the embedded migrations of the
+// storage, postgres or sqlite.
+func NewProvider(storage string, db *sql.DB) (*goose.Provider, error) {
+	var dialect goose.Dialect
+	var fsys fs.FS
+
+	switch storage {
+	case "postgres":
+		dialect, fsys = goose.DialectPostgres, migrations.Postgres()
+	case "sqlite":
+		dialect, fsys = goose.DialectSQLite3, migrations.SQLite()
+	default:
+		return nil, ErrorUnknownStorage
+	}
+
+	return goose.NewProvider(dialect, db, fsys)
+}
+
+// Run does what the mode says with the migrations at startup.
+func Run(ctx context.Context, provider *goose.Provider, mode Mode) error {
+	switch mode {
+	case ModeUp:
+		results, err := provider.Up(ctx)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			slog.Info("Applied the migration", "migration", result)
+		}
+		return nil
+
+	case ModeCheck:
+		pending, err := provider.HasPending(ctx)
+		if err != nil {
+			return err
+		}
+		if pending {
+			return ErrorPendingMigrations
+		}
+		return nil
+
+	case ModeOff:
+		return nil
+
+	default:
+		return ErrorUnknownMode
+	}
+}
+
+// Command runs the migrate subcommand: up applies the pending migrations,
+// down rolls back the last one, status lists the migrations and version
+// prints the version of the database.
+func Command(ctx context.Context, provider *goose.Provider, command string) error {
+	switch command {
+	case "up":
+		return Run(ctx, provider, ModeUp)
+
+	case "down":
+		result, err := provider.Down(ctx)
+		if err != nil {
+			return err
+		}
+		slog.Info("Rolled back the migration", "migration", result)
+		return nil
+
+	case "status":
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			applied := ""
+			if status.State == goose.StateApplied {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%-8s %-20s %s\n", status.State, applied, status.Source.Path)
+		}
+		return nil
+
+	case "version":
+		version, err := provider.GetDBVersion(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(version)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down, status or version", command)
+	}
+}
@@ -0,0 +1,68 @@
+package migrate
+
+import (
+	db_song "MusicPlayerProject/internal/db"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, mode := range []Mode{ModeUp, ModeCheck, ModeOff} {
+		parsed, err := ParseMode(string(mode))
+		assert.NoError(t, err, "expected no error, but got: %v", err)
+		assert.Equal(t, mode, parsed, "expected the parsed mode")
+	}
+
+	_, err := ParseMode("auto")
+	assert.ErrorIs(t, err, ErrorUnknownMode, "expected ErrorUnknownMode, but got: %v", err)
+}
+
+func TestNewProvider(t *testing.T) {
+	db, err := db_song.OpenSQLite(filepath.Join(t.TempDir(), "playlist.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// the PostgreSQL migrations do not include the SQLite ones
+	provider, err := NewProvider("postgres", db)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Len(t, provider.ListSources(), 8, "expected the PostgreSQL migrations")
+
+	provider, err = NewProvider("sqlite", db)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Len(t, provider.ListSources(), 1, "expected the SQLite migrations")
+
+	_, err = NewProvider("memory", db)
+	assert.ErrorIs(t, err, ErrorUnknownStorage, "expected ErrorUnknownStorage, but got: %v", err)
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	db, err := db_song.OpenSQLite(filepath.Join(t.TempDir(), "playlist.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	provider, err := NewProvider("sqlite", db)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	assert.NoError(t, Run(ctx, provider, ModeOff), "expected the off mode to skip the migrations")
+	err = Run(ctx, provider, ModeCheck)
+	assert.ErrorIs(t, err, ErrorPendingMigrations, "expected ErrorPendingMigrations, but got: %v", err)
+
+	assert.NoError(t, Run(ctx, provider, ModeUp), "expected the migrations to be applied")
+	assert.NoError(t, Run(ctx, provider, ModeCheck), "expected no pending migrations")
+
+	version, err := provider.GetDBVersion(ctx)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, int64(20241231100000), version, "expected the version of the last migration")
+
+	assert.NoError(t, Command(ctx, provider, "status"))
+	assert.NoError(t, Command(ctx, provider, "version"))
+	assert.NoError(t, Command(ctx, provider, "down"), "expected the last migration to be rolled back")
+	assert.Error(t, Command(ctx, provider, "redo"), "expected an error for an unknown command")
+
+	err = Run(ctx, provider, ModeCheck)
+	assert.ErrorIs(t, err, ErrorPendingMigrations, "expected ErrorPendingMigrations, but got: %v", err)
+}
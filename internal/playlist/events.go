@@ -0,0 +1,130 @@
+package playlist
+
+import (
+	"sync"
+	"time"
+)
+
+type EventType int
+
+const (
+	EventSongStarted EventType = iota
+	EventSongFinished
+	EventPaused
+	EventResumed
+	EventSkipped
+	EventSongAdded
+	EventSongRemoved
+	EventSongUpdated
+	EventStopped
+	EventSongMoved
+	EventSongQueued
+)
+
+// Event describes a change of the player. Song is a copy of the song the
+// event is about: the started, finished, skipped or changed one.
+type Event struct {
+	Type EventType
+	Song Song
+	Time time.Time
+}
+
+// OverflowPolicy decides what happens to an event when the buffer of a
+// subscriber is full. The player never waits for slow subscribers.
+type OverflowPolicy int
+
+const (
+	DropOldest OverflowPolicy = iota
+	DropNewest
+)
+
+type Subscription struct {
+	events    chan Event
+	policy    OverflowPolicy
+	dropped   uint64
+	publisher *eventPublisher
+}
+
+// Events returns the channel with the events. It is closed after Close.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns how many events were lost because the buffer was full.
+func (s *Subscription) Dropped() uint64 {
+	s.publisher.mutex.Lock()
+	defer s.publisher.mutex.Unlock()
+
+	return s.dropped
+}
+
+func (s *Subscription) Close() {
+	s.publisher.unsubscribe(s)
+}
+
+type eventPublisher struct {
+	mutex       sync.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+func newEventPublisher() *eventPublisher {
+	return &eventPublisher{subscribers: make(map[*Subscription]struct{})}
+}
+
+func (p *eventPublisher) subscribe(bufferSize int, policy OverflowPolicy) *Subscription {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+
+	s := &Subscription{
+		events:    make(chan Event, bufferSize),
+		policy:    policy,
+		publisher: p,
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.subscribers[s] = struct{}{}
+	return s
+}
+
+func (p *eventPublisher) unsubscribe(s *Subscription) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, ok := p.subscribers[s]; !ok {
+		return
+	}
+	delete(p.subscribers, s)
+	close(s.events)
+}
+
+// publish never blocks: an event that does not fit into the buffer of a
+// subscriber is dropped according to its policy.
+func (p *eventPublisher) publish(e Event) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for s := range p.subscribers {
+		select {
+		case s.events <- e:
+			continue
+		default:
+		}
+
+		s.dropped++
+		if s.policy == DropNewest {
+			continue
+		}
+
+		select {
+		case <-s.events:
+		default:
+		}
+		select {
+		case s.events <- e:
+		default:
+		}
+	}
+}
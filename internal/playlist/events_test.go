@@ -0,0 +1,61 @@
+package playlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribe(t *testing.T) {
+	p := NewPlaylist().(*playlist)
+	subscription := p.Subscribe(10, DropOldest)
+	defer subscription.Close()
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.Play()
+	p.Pause()
+	p.Play()
+	p.Next()
+
+	expected := []struct {
+		eventType EventType
+		title     string
+	}{
+		{EventSongAdded, "Song 1"},
+		{EventSongAdded, "Song 2"},
+		{EventSongStarted, "Song 1"},
+		{EventPaused, "Song 1"},
+		{EventResumed, "Song 1"},
+		{EventSkipped, "Song 1"},
+		{EventSongStarted, "Song 2"},
+	}
+	for _, e := range expected {
+		event := <-subscription.Events()
+		assert.Equal(t, e.eventType, event.Type, "expected event type %v, but get: %v", e.eventType, event.Type)
+		assert.Equal(t, e.title, event.Song.Title, "expected event about %q, but get: %q", e.title, event.Song.Title)
+	}
+}
+
+func TestSubscribeOverflow(t *testing.T) {
+	p := NewPlaylist().(*playlist)
+	oldest := p.Subscribe(2, DropOldest)
+	newest := p.Subscribe(2, DropNewest)
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.AddSong("Song 3", 150*time.Second)
+
+	assert.Equal(t, uint64(1), oldest.Dropped(), "expected 1 dropped event")
+	assert.Equal(t, "Song 2", (<-oldest.Events()).Song.Title, "expected the oldest event to be dropped")
+	assert.Equal(t, "Song 3", (<-oldest.Events()).Song.Title, "expected the newest event to be kept")
+
+	assert.Equal(t, uint64(1), newest.Dropped(), "expected 1 dropped event")
+	assert.Equal(t, "Song 1", (<-newest.Events()).Song.Title, "expected the oldest event to be kept")
+	assert.Equal(t, "Song 2", (<-newest.Events()).Song.Title, "expected the newest event to be dropped")
+
+	oldest.Close()
+	_, ok := <-oldest.Events()
+	assert.False(t, ok, "expected the events channel to be closed")
+}
@@ -3,8 +3,12 @@ package playlist
 import (
 	"container/list"
 	"errors"
+	"fmt"
+	"maps"
 	"sync"
 	"time"
+
+	"MusicPlayerProject/internal/clock"
 )
 
 var (
@@ -16,21 +20,103 @@ var (
 	ErrorPausedPlaylist       = errors.New("The playlist is paused")
 	ErrorPlayingSong          = errors.New("The song is playing now")
 	ErrorNotFoundSong         = errors.New("The song is not found")
+	ErrorNotValidRepeatMode   = errors.New("The repeat mode is not valid")
+	ErrorNotValidIndex        = errors.New("The index is out of the playlist")
 )
 
-type Song struct {
-	Title    string
+// SeekOutOfRangeError is returned by Seek when the offset does not fit into the current song.
+type SeekOutOfRangeError struct {
+	Offset   time.Duration
 	Duration time.Duration
 }
 
+func (e *SeekOutOfRangeError) Error() string {
+	return fmt.Sprintf("The offset %v is out of the song duration %v", e.Offset, e.Duration)
+}
+
+// Song is an entry of the playlist. ID is the ID of the song in the library,
+// it is zero for the songs added without one. The metadata is copied from
+// the library and is not used by the player itself.
+type Song struct {
+	ID          int
+	Title       string
+	Duration    time.Duration
+	Artist      string
+	Album       string
+	AlbumArtist string
+	TrackNumber int
+	DiscNumber  int
+	Genre       string
+	Year        int
+	Tags        map[string]string
+}
+
+type PlaybackStatus int
+
+const (
+	StatusStopped PlaybackStatus = iota
+	StatusPlaying
+	StatusPaused
+)
+
+type RepeatMode int
+
+const (
+	// RepeatOff stops the player after the last song.
+	RepeatOff RepeatMode = iota
+	// RepeatAll starts the playlist over after the last song.
+	RepeatAll
+	// RepeatOne plays the current song again and again.
+	RepeatOne
+)
+
+// PlaybackState is a snapshot of the player. Song is nil and Index is -1
+// when there is no current song yet. Index is also -1 while the current song
+// is played from the play-next queue.
+type PlaybackState struct {
+	Song        *Song
+	Index       int
+	Elapsed     time.Duration
+	Remaining   time.Duration
+	Status      PlaybackStatus
+	Shuffle     bool
+	ShuffleSeed int64
+	Repeat      RepeatMode
+}
+
 type IBasePlaybackMusicPlayer interface {
 	Play() error
 	Pause() error
+	Stop() error
 	Next() error
 	Prev() error
+	PlaySongByTitle(title string) error
+	PlayAt(index int) error
 	AddSong(title string, duration time.Duration) error
+	AddLibrarySong(song Song) error
+	InsertSong(title string, duration time.Duration, index int) error
+	InsertLibrarySong(song Song, index int) error
 	DeleteSong(title string) error
+	DeleteSongByID(id int) error
+	DeleteAt(index int) error
 	UpdateSong(oldTitle string, newTitle string, newDuration time.Duration) error
+	UpdateLibrarySong(song Song) error
+	MoveSong(title string, index int) error
+	MoveAt(from int, to int) error
+	SwapSongs(first string, second string) error
+	Songs() []Song
+	EnqueueNext(title string) error
+	Queue() []Song
+	ClearQueue()
+	Position() time.Duration
+	Seek(offset time.Duration) error
+	State() PlaybackState
+	Subscribe(bufferSize int, policy OverflowPolicy) *Subscription
+	SetShuffle(enabled bool, seed int64)
+	SetRepeatMode(mode RepeatMode) error
+	Restore(title string, position time.Duration) error
+	RestoreByID(id int, position time.Duration) error
+	RestoreAt(index int, position time.Duration) error
 }
 
 type playlist struct {
@@ -39,32 +125,69 @@ type playlist struct {
 	isPlaying     bool
 	isPaused      bool
 	playbackMutex sync.Mutex
-	playbackCond  *sync.Cond
 	stopChan      chan struct{}
+	timer         clock.Timer
+
+	// elapsed is the part of the current song played before startedAt,
+	// startedAt is the moment the running playback goroutine was started.
+	elapsed   time.Duration
+	startedAt time.Time
+
+	events *eventPublisher
+
+	// queue is the play-next queue, queued is the element of the queued song
+	// being played and anchor is the song to continue the play order from.
+	queue  *list.List
+	queued *list.Element
+	anchor *list.Element
+
+	// shuffle is nil when the songs are played in the stored order.
+	shuffle *shuffleOrder
+	repeat  RepeatMode
+
+	clock clock.Clock
 }
 
 func NewPlaylist() IBasePlaybackMusicPlayer {
+	return NewPlaylistWithClock(clock.New())
+}
+
+// NewPlaylistWithClock creates a playlist which measures the songs with
+// the given clock, e.g. with a fake one in tests.
+func NewPlaylistWithClock(clock clock.Clock) IBasePlaybackMusicPlayer {
 	p := &playlist{
-		songs:    list.New(),
-		stopChan: make(chan struct{}),
+		songs:  list.New(),
+		queue:  list.New(),
+		events: newEventPublisher(),
+		repeat: RepeatAll,
+		clock:  clock,
 	}
-	p.playbackCond = sync.NewCond(&p.playbackMutex)
 	return p
 }
 
 func (p *playlist) AddSong(title string, duration time.Duration) error {
-	if title == "" {
+	return p.AddLibrarySong(Song{Title: title, Duration: duration})
+}
+
+// AddLibrarySong appends the song of the library with its ID and metadata
+// to the end of the playlist.
+func (p *playlist) AddLibrarySong(s Song) error {
+	if s.Title == "" {
 		return ErrorEmptyTitleSong
 	}
-	if duration <= 0 {
+	if s.Duration <= 0 {
 		return ErrorNotValidDurationSong
 	}
 
 	p.playbackMutex.Lock()
 	defer p.playbackMutex.Unlock()
 
-	song := &Song{Title: title, Duration: duration}
-	p.songs.PushBack(song)
+	song := newEntry(s)
+	e := p.songs.PushBack(song)
+	if p.shuffle != nil {
+		p.shuffle.insert(e, p.cursor())
+	}
+	p.publish(EventSongAdded, song)
 	return nil
 }
 
@@ -82,23 +205,19 @@ func (p *playlist) Play() error {
 		}
 
 		p.isPaused = false
-		p.playbackCond.Signal()
+		p.startPlayback()
+		p.publish(EventResumed, p.currentSong.Value.(*Song))
 		return nil
 	}
 
 	if p.currentSong == nil {
-		p.currentSong = p.songs.Front()
+		p.currentSong = p.firstElement()
 	}
 
 	p.isPlaying = true
 	p.isPaused = false
-
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go p.playback(&wg)
-	go func() {
-		wg.Wait()
-	}()
+	p.startPlayback()
+	p.publish(EventSongStarted, p.currentSong.Value.(*Song))
 	return nil
 }
 
@@ -114,36 +233,45 @@ func (p *playlist) Pause() error {
 		return ErrorPausedPlaylist
 	}
 
+	p.stopPlayback()
 	p.isPaused = true
+	p.publish(EventPaused, p.currentSong.Value.(*Song))
 	return nil
 }
 
-func (p *playlist) Next() error {
+// Stop ends the playback and rewinds the current song. Unlike a paused
+// song, the stopped one is not considered playing and can be deleted.
+func (p *playlist) Stop() error {
 	p.playbackMutex.Lock()
 	defer p.playbackMutex.Unlock()
 
-	if p.currentSong == nil {
-		return ErrorEmptyPlaylist
+	if !p.isPlaying {
+		return ErrorNotPlayingPlaylist
 	}
 
-	if p.currentSong.Next() == nil {
-		p.currentSong = p.songs.Front()
-	} else {
-		p.currentSong = p.currentSong.Next()
-	}
+	p.stopPlayback()
+	p.isPlaying = false
+	p.isPaused = false
+	p.elapsed = 0
+	p.publish(EventStopped, p.currentSong.Value.(*Song))
+	return nil
+}
 
-	if p.isPlaying && !p.isPaused {
-		close(p.stopChan)
-		p.stopChan = make(chan struct{})
+func (p *playlist) Next() error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
 
-		var wg sync.WaitGroup
-		wg.Add(1)
-		go p.playback(&wg)
+	if p.currentSong == nil {
+		return ErrorEmptyPlaylist
+	}
 
-		go func() {
-			wg.Wait()
-		}()
+	next := p.upNext()
+	p.publish(EventSkipped, p.currentSong.Value.(*Song))
+	if next == nil {
+		p.rewind()
+		return nil
 	}
+	p.switchSong(next)
 	return nil
 }
 
@@ -155,27 +283,90 @@ func (p *playlist) Prev() error {
 		return ErrorEmptyPlaylist
 	}
 
-	if p.currentSong.Prev() == nil {
-		p.currentSong = p.songs.Back()
+	var prev *list.Element
+	if p.isQueued() {
+		// the song before a queued one is the song it was played after
+		prev = p.anchor
 	} else {
-		p.currentSong = p.currentSong.Prev()
+		prev = p.prevElement(p.currentSong)
 	}
+	if prev == nil {
+		// the first song is restarted when the playlist does not repeat
+		prev = p.currentSong
+	}
+	p.publish(EventSkipped, p.currentSong.Value.(*Song))
+	p.switchSong(prev)
+	return nil
+}
 
-	if p.isPlaying && !p.isPaused {
-		close(p.stopChan)
-		p.stopChan = make(chan struct{})
+// PlaySongByTitle starts the first entry of the song from the beginning,
+// whether the player is playing, paused or stopped.
+func (p *playlist) PlaySongByTitle(title string) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
 
-		var wg sync.WaitGroup
-		wg.Add(1)
-		go p.playback(&wg)
-		go func() {
-			wg.Wait()
-		}()
+	e := p.find(title)
+	if e == nil {
+		return ErrorNotFoundSong
 	}
+	p.jumpTo(e)
 	return nil
 }
 
+// PlayAt starts the song at the index of the stored order from the beginning.
+func (p *playlist) PlayAt(index int) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if index < 0 || index >= p.songs.Len() {
+		return ErrorNotValidIndex
+	}
+	p.jumpTo(p.elementAt(index))
+	return nil
+}
+
+// jumpTo stops the playback of the current song and plays e instead. The
+// play-next queue is kept. It must be called with playbackMutex held.
+func (p *playlist) jumpTo(e *list.Element) {
+	if p.isPlaying {
+		p.publish(EventSkipped, p.currentSong.Value.(*Song))
+	}
+
+	p.stopPlayback()
+	p.currentSong = e
+	p.queued = nil
+	p.elapsed = 0
+	p.isPlaying = true
+	p.isPaused = false
+	p.startPlayback()
+	p.publish(EventSongStarted, e.Value.(*Song))
+}
+
 func (p *playlist) DeleteSong(title string) error {
+	return p.deleteWhere(func(song *Song) bool { return song.Title == title })
+}
+
+// DeleteSongByID deletes the first entry of the library song with the ID.
+func (p *playlist) DeleteSongByID(id int) error {
+	return p.deleteWhere(func(song *Song) bool { return song.ID == id })
+}
+
+// DeleteAt deletes the entry at the index.
+func (p *playlist) DeleteAt(index int) error {
+	i := 0
+	err := p.deleteWhere(func(song *Song) bool {
+		i++
+		return i-1 == index
+	})
+	if errors.Is(err, ErrorNotFoundSong) {
+		return ErrorNotValidIndex
+	}
+	return err
+}
+
+// deleteWhere deletes the first entry of the playlist the match function
+// accepts. The current song is deleted only while the player is stopped.
+func (p *playlist) deleteWhere(match func(song *Song) bool) error {
 	p.playbackMutex.Lock()
 	defer p.playbackMutex.Unlock()
 
@@ -185,11 +376,49 @@ func (p *playlist) DeleteSong(title string) error {
 
 	for e := p.songs.Front(); e != nil; e = e.Next() {
 		song := e.Value.(*Song)
-		if song.Title == title {
+		if match(song) {
 			if e == p.currentSong {
-				return ErrorPlayingSong
+				if p.isPlaying {
+					return ErrorPlayingSong
+				}
+				p.currentSong = p.nextElement(e)
+				if p.currentSong == e {
+					p.currentSong = nil
+				}
+				p.elapsed = 0
+			}
+			// a song played from the queue is a copy of the element of its entry
+			if p.isQueued() && p.currentSong.Value == e.Value {
+				if p.isPlaying {
+					return ErrorPlayingSong
+				}
+				// the stopped player goes on after the song the queued one was played after
+				next := p.firstElement()
+				if p.anchor != nil {
+					next = p.nextElement(p.anchor)
+				}
+				if next == e {
+					next = p.nextElement(e)
+				}
+				if next == e {
+					next = nil
+				}
+				p.currentSong = next
+				p.queued = nil
+				p.elapsed = 0
+			}
+			if e == p.anchor {
+				p.anchor = p.prevElement(e)
+				if p.anchor == e {
+					p.anchor = nil
+				}
 			}
+			if p.shuffle != nil {
+				p.shuffle.remove(e)
+			}
+			p.dequeue(song)
 			p.songs.Remove(e)
+			p.publish(EventSongRemoved, song)
 			return nil
 		}
 	}
@@ -198,6 +427,44 @@ func (p *playlist) DeleteSong(title string) error {
 }
 
 func (p *playlist) UpdateSong(oldTitle string, newTitle string, newDuration time.Duration) error {
+	return p.updateWhere(func(song *Song) bool { return song.Title == oldTitle }, newTitle, newDuration)
+}
+
+// UpdateLibrarySong gives all entries of the library song with the ID of s
+// its title, duration and metadata.
+func (p *playlist) UpdateLibrarySong(s Song) error {
+	if s.Title == "" {
+		return ErrorEmptyTitleSong
+	}
+	if s.Duration <= 0 {
+		return ErrorNotValidDurationSong
+	}
+
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if p.songs.Len() == 0 {
+		return ErrorEmptyPlaylist
+	}
+
+	// the entries are changed in place, so the queued copies follow them
+	found := false
+	for e := p.songs.Front(); e != nil; e = e.Next() {
+		song := e.Value.(*Song)
+		if song.ID == s.ID {
+			*song = *newEntry(s)
+			p.publish(EventSongUpdated, song)
+			found = true
+		}
+	}
+
+	if !found {
+		return ErrorNotFoundSong
+	}
+	return nil
+}
+
+func (p *playlist) updateWhere(match func(song *Song) bool, newTitle string, newDuration time.Duration) error {
 	p.playbackMutex.Lock()
 	defer p.playbackMutex.Unlock()
 
@@ -209,11 +476,267 @@ func (p *playlist) UpdateSong(oldTitle string, newTitle string, newDuration time
 		return ErrorEmptyTitleSong
 	}
 
+	// the same song may be in the playlist more than once
+	found := false
 	for e := p.songs.Front(); e != nil; e = e.Next() {
 		song := e.Value.(*Song)
-		if song.Title == oldTitle {
+		if match(song) {
 			song.Title = newTitle
 			song.Duration = newDuration
+			p.publish(EventSongUpdated, song)
+			found = true
+		}
+	}
+
+	if !found {
+		return ErrorNotFoundSong
+	}
+	return nil
+}
+
+// InsertSong inserts the song before the song at the index, the index equal
+// to the length of the playlist appends it to the end.
+func (p *playlist) InsertSong(title string, duration time.Duration, index int) error {
+	return p.InsertLibrarySong(Song{Title: title, Duration: duration}, index)
+}
+
+// InsertLibrarySong inserts the song of the library at the index.
+func (p *playlist) InsertLibrarySong(s Song, index int) error {
+	if s.Title == "" {
+		return ErrorEmptyTitleSong
+	}
+	if s.Duration <= 0 {
+		return ErrorNotValidDurationSong
+	}
+
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if index < 0 || index > p.songs.Len() {
+		return ErrorNotValidIndex
+	}
+
+	song := newEntry(s)
+
+	var e *list.Element
+	if index == p.songs.Len() {
+		e = p.songs.PushBack(song)
+	} else {
+		e = p.songs.InsertBefore(song, p.elementAt(index))
+	}
+	if p.shuffle != nil {
+		p.shuffle.insert(e, p.cursor())
+	}
+	p.publish(EventSongAdded, song)
+	return nil
+}
+
+// MoveSong moves the first entry of the song to the index. The elements are
+// moved, not recreated, so the current song and the shuffle order stay valid.
+func (p *playlist) MoveSong(title string, index int) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	e := p.find(title)
+	if e == nil {
+		return ErrorNotFoundSong
+	}
+	return p.moveTo(e, index)
+}
+
+// MoveAt moves the entry at the index from to the index to.
+func (p *playlist) MoveAt(from int, to int) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if from < 0 || from >= p.songs.Len() {
+		return ErrorNotValidIndex
+	}
+	return p.moveTo(p.elementAt(from), to)
+}
+
+func (p *playlist) moveTo(e *list.Element, index int) error {
+	if index < 0 || index >= p.songs.Len() {
+		return ErrorNotValidIndex
+	}
+
+	mark := p.elementAt(index)
+	if mark == e {
+		return nil
+	}
+
+	if p.indexOf(e) < index {
+		p.songs.MoveAfter(e, mark)
+	} else {
+		p.songs.MoveBefore(e, mark)
+	}
+	p.publish(EventSongMoved, e.Value.(*Song))
+	return nil
+}
+
+// SwapSongs swaps the places of the first entries of the two songs.
+func (p *playlist) SwapSongs(first string, second string) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	a := p.find(first)
+	b := p.find(second)
+	if a == nil || b == nil {
+		return ErrorNotFoundSong
+	}
+	if a == b {
+		return nil
+	}
+
+	if a.Prev() == b {
+		p.songs.MoveBefore(a, b)
+	} else {
+		prev := a.Prev()
+		p.songs.MoveAfter(a, b)
+		if prev == nil {
+			p.songs.MoveToFront(b)
+		} else {
+			p.songs.MoveAfter(b, prev)
+		}
+	}
+	p.publish(EventSongMoved, a.Value.(*Song))
+	p.publish(EventSongMoved, b.Value.(*Song))
+	return nil
+}
+
+// Songs returns copies of the songs in the stored order.
+func (p *playlist) Songs() []Song {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	songs := make([]Song, 0, p.songs.Len())
+	for e := p.songs.Front(); e != nil; e = e.Next() {
+		songs = append(songs, *e.Value.(*Song))
+	}
+	return songs
+}
+
+// newEntry returns a new entry of the song. The tags are copied, so the
+// entry does not share them with the caller.
+func newEntry(s Song) *Song {
+	s.Tags = maps.Clone(s.Tags)
+	return &s
+}
+
+// find returns the first entry of the song or nil.
+func (p *playlist) find(title string) *list.Element {
+	for e := p.songs.Front(); e != nil; e = e.Next() {
+		if e.Value.(*Song).Title == title {
+			return e
+		}
+	}
+	return nil
+}
+
+func (p *playlist) elementAt(index int) *list.Element {
+	e := p.songs.Front()
+	for i := 0; i < index && e != nil; i++ {
+		e = e.Next()
+	}
+	return e
+}
+
+func (p *playlist) indexOf(e *list.Element) int {
+	i := 0
+	for el := p.songs.Front(); el != nil; el = el.Next() {
+		if el == e {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Position returns how much of the current song has already been played.
+func (p *playlist) Position() time.Duration {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	return p.position()
+}
+
+// Seek moves the cursor of the current song to offset. The playing song
+// continues from the new position, the paused one keeps waiting for Play.
+func (p *playlist) Seek(offset time.Duration) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if p.songs.Len() == 0 {
+		return ErrorEmptyPlaylist
+	}
+
+	// the cursor moves to the first song only when the seek is accepted
+	current := p.currentSong
+	if current == nil {
+		current = p.firstElement()
+	}
+
+	song := current.Value.(*Song)
+	if offset < 0 || offset > song.Duration {
+		return &SeekOutOfRangeError{Offset: offset, Duration: song.Duration}
+	}
+	p.currentSong = current
+
+	running := p.isPlaying && !p.isPaused
+	if running {
+		p.stopPlayback()
+	}
+
+	p.elapsed = offset
+
+	if running {
+		p.startPlayback()
+	}
+	return nil
+}
+
+// Restore puts the cursor of the stopped player on the song with the given
+// title, so that Play continues it from position. A position outside of the
+// song starts it from the beginning.
+func (p *playlist) Restore(title string, position time.Duration) error {
+	return p.restoreWhere(func(song *Song) bool { return song.Title == title }, position)
+}
+
+// RestoreByID is Restore for the first entry of the library song with the ID.
+func (p *playlist) RestoreByID(id int, position time.Duration) error {
+	return p.restoreWhere(func(song *Song) bool { return song.ID == id }, position)
+}
+
+// RestoreAt is Restore for the entry at the index.
+func (p *playlist) RestoreAt(index int, position time.Duration) error {
+	i := 0
+	err := p.restoreWhere(func(song *Song) bool {
+		i++
+		return i-1 == index
+	}, position)
+	if errors.Is(err, ErrorNotFoundSong) {
+		return ErrorNotValidIndex
+	}
+	return err
+}
+
+func (p *playlist) restoreWhere(match func(song *Song) bool, position time.Duration) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if p.isPlaying {
+		return ErrorPlayingPlaylist
+	}
+
+	for e := p.songs.Front(); e != nil; e = e.Next() {
+		song := e.Value.(*Song)
+		if match(song) {
+			if position < 0 || position >= song.Duration {
+				position = 0
+			}
+			p.currentSong = e
+			p.queued = nil
+			p.elapsed = position
 			return nil
 		}
 	}
@@ -221,28 +744,228 @@ func (p *playlist) UpdateSong(oldTitle string, newTitle string, newDuration time
 	return ErrorNotFoundSong
 }
 
-func (p *playlist) playback(wg *sync.WaitGroup) {
-	defer wg.Done()
-	for {
-		p.playbackMutex.Lock()
+// State returns a snapshot of the current song, its position and the player status.
+func (p *playlist) State() PlaybackState {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
 
-		for p.isPaused {
-			p.playbackCond.Wait()
+	state := PlaybackState{
+		Index:   -1,
+		Status:  StatusStopped,
+		Shuffle: p.shuffle != nil,
+		Repeat:  p.repeat,
+	}
+	if p.isPlaying {
+		state.Status = StatusPlaying
+		if p.isPaused {
+			state.Status = StatusPaused
 		}
+	}
 
-		if !p.isPlaying {
+	if p.shuffle != nil {
+		state.ShuffleSeed = p.shuffle.seed
+	}
+
+	if p.currentSong == nil {
+		return state
+	}
+
+	song := *p.currentSong.Value.(*Song)
+	state.Song = &song
+	state.Elapsed = p.position()
+	state.Remaining = max(song.Duration-state.Elapsed, 0)
+
+	state.Index = p.indexOf(p.currentSong)
+
+	return state
+}
+
+// SetShuffle turns the shuffle mode on or off. The same seed always gives
+// the same order of the same songs, zero included. The order starts at the
+// current song, so the next song is the one the seed puts after it.
+func (p *playlist) SetShuffle(enabled bool, seed int64) {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	if !enabled {
+		p.shuffle = nil
+		return
+	}
+	p.shuffle = newShuffleOrder(p.songs, seed, p.cursor())
+}
+
+// firstElement, nextElement and prevElement walk the songs in the play
+// order. They must be called with playbackMutex held.
+func (p *playlist) firstElement() *list.Element {
+	if p.shuffle != nil && len(p.shuffle.order) > 0 {
+		return p.shuffle.order[0]
+	}
+	return p.songs.Front()
+}
+
+func (p *playlist) lastElement() *list.Element {
+	if p.shuffle != nil && len(p.shuffle.order) > 0 {
+		return p.shuffle.order[len(p.shuffle.order)-1]
+	}
+	return p.songs.Back()
+}
+
+// nextElement and prevElement wrap around only in the RepeatAll and
+// RepeatOne modes, otherwise they return nil at the ends of the playlist.
+func (p *playlist) nextElement(e *list.Element) *list.Element {
+	var next *list.Element
+	if p.shuffle != nil {
+		next = p.shuffle.next(e)
+	} else {
+		next = e.Next()
+	}
+
+	if next == nil && p.repeat != RepeatOff {
+		next = p.firstElement()
+	}
+	return next
+}
+
+func (p *playlist) prevElement(e *list.Element) *list.Element {
+	var prev *list.Element
+	if p.shuffle != nil {
+		prev = p.shuffle.prev(e)
+	} else {
+		prev = e.Prev()
+	}
+
+	if prev == nil && p.repeat != RepeatOff {
+		prev = p.lastElement()
+	}
+	return prev
+}
+
+// rewind stops the player at the end of the playlist and moves the cursor
+// back to its beginning. It must be called with playbackMutex held.
+func (p *playlist) rewind() {
+	wasPlaying := p.isPlaying
+	song := p.currentSong.Value.(*Song)
+
+	p.stopPlayback()
+	p.isPlaying = false
+	p.isPaused = false
+	p.elapsed = 0
+	p.currentSong = p.firstElement()
+	p.queued = nil
+
+	if wasPlaying {
+		p.publish(EventStopped, song)
+	}
+}
+
+// SetRepeatMode changes what happens after the last song of the playlist.
+func (p *playlist) SetRepeatMode(mode RepeatMode) error {
+	if mode < RepeatOff || mode > RepeatOne {
+		return ErrorNotValidRepeatMode
+	}
+
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	p.repeat = mode
+	return nil
+}
+
+// Subscribe registers a new listener of the player events. Every subscriber
+// has its own buffer, so a slow one cannot stall the playback.
+func (p *playlist) Subscribe(bufferSize int, policy OverflowPolicy) *Subscription {
+	return p.events.subscribe(bufferSize, policy)
+}
+
+// publish must be called with playbackMutex held.
+func (p *playlist) publish(eventType EventType, song *Song) {
+	p.events.publish(Event{Type: eventType, Song: *song, Time: p.clock.Now()})
+}
+
+// position must be called with playbackMutex held.
+func (p *playlist) position() time.Duration {
+	if p.isPlaying && !p.isPaused {
+		return p.elapsed + p.clock.Now().Sub(p.startedAt)
+	}
+	return p.elapsed
+}
+
+// switchSong makes e the current song and starts it from the beginning.
+// It must be called with playbackMutex held.
+func (p *playlist) switchSong(e *list.Element) {
+	running := p.isPlaying && !p.isPaused
+	if running {
+		p.stopPlayback()
+	}
+
+	p.currentSong = e
+	p.elapsed = 0
+
+	if running {
+		p.startPlayback()
+		p.publish(EventSongStarted, e.Value.(*Song))
+	}
+}
+
+// startPlayback runs the playback goroutine for the remaining part of the
+// current song. It must be called with playbackMutex held.
+func (p *playlist) startPlayback() {
+	song := p.currentSong.Value.(*Song)
+
+	p.stopChan = make(chan struct{})
+	p.startedAt = p.clock.Now()
+	p.timer = p.clock.NewTimer(song.Duration - p.elapsed)
+	go p.playback(p.stopChan, p.timer)
+}
+
+// stopPlayback stops the running playback goroutine and remembers how much
+// of the current song has been played. It must be called with playbackMutex held.
+func (p *playlist) stopPlayback() {
+	if p.stopChan == nil {
+		return
+	}
+	p.elapsed += p.clock.Now().Sub(p.startedAt)
+	p.timer.Stop()
+	close(p.stopChan)
+	p.stopChan = nil
+}
+
+func (p *playlist) playback(stop chan struct{}, timer clock.Timer) {
+	for {
+		select {
+		case <-timer.C():
+		case <-stop:
+			return
+		}
+
+		p.playbackMutex.Lock()
+		select {
+		case <-stop:
+			// playback was stopped while we were waiting for the lock
 			p.playbackMutex.Unlock()
 			return
+		default:
 		}
 
-		song := p.currentSong.Value.(*Song)
-		p.playbackMutex.Unlock()
+		p.publish(EventSongFinished, p.currentSong.Value.(*Song))
 
-		select {
-		case <-time.After(song.Duration):
-			p.Next()
-		case <-p.stopChan:
+		next := p.currentSong
+		if p.repeat != RepeatOne {
+			next = p.upNext()
+		}
+		if next == nil {
+			p.rewind()
+			p.playbackMutex.Unlock()
 			return
 		}
+
+		song := next.Value.(*Song)
+		p.currentSong = next
+		p.elapsed = 0
+		p.startedAt = p.clock.Now()
+		p.timer = p.clock.NewTimer(song.Duration)
+		timer = p.timer
+		p.publish(EventSongStarted, song)
+		p.playbackMutex.Unlock()
 	}
 }
@@ -4,9 +4,16 @@ import (
 	"testing"
 	"time"
 
+	"MusicPlayerProject/internal/clock/clocktest"
+
 	"github.com/stretchr/testify/assert"
 )
 
+func newTestPlaylist() (*playlist, *clocktest.Clock) {
+	clock := clocktest.NewClock(time.Now())
+	return NewPlaylistWithClock(clock).(*playlist), clock
+}
+
 func TestAddSong(t *testing.T) {
 	p := NewPlaylist().(*playlist)
 
@@ -56,10 +63,20 @@ func TestUpdateSong(t *testing.T) {
 
 	err = p.UpdateSong("Song 11", "", 4*time.Second)
 	assert.Equal(t, ErrorEmptyTitleSong, err, "expected error %v, but get: %v", ErrorEmptyTitleSong, err)
+
+	// every entry of a song added twice is updated
+	p.AddSong("Song 2", 2*time.Second)
+	p.AddSong("Song 11", 4*time.Second)
+
+	err = p.UpdateSong("Song 11", "Song 1", 5*time.Second)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, "Song 1", p.songs.Front().Value.(*Song).Title, "expected the title of the first song to be 'Song 1'")
+	assert.Equal(t, "Song 1", p.songs.Back().Value.(*Song).Title, "expected the title of the last song to be 'Song 1'")
+	assert.Equal(t, 5*time.Second, p.songs.Back().Value.(*Song).Duration, "expected the duration of the last song to be 5 second")
 }
 
 func TestPlay(t *testing.T) {
-	p := NewPlaylist().(*playlist)
+	p, clock := newTestPlaylist()
 
 	p.AddSong("Song 1", 1*time.Second)
 	p.AddSong("Song 2", 1*time.Second)
@@ -68,12 +85,16 @@ func TestPlay(t *testing.T) {
 	err := p.Play()
 	assert.NoError(t, err, "expected no error, but got: %v", err)
 
-	time.Sleep(1100 * time.Millisecond)
-	assert.Equal(t, "Song 2", p.currentSong.Value.(*Song).Title, "expected 'Song 2' to be playing")
+	clock.BlockUntil(1)
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing")
 
-	time.Sleep(1100 * time.Millisecond)
-	assert.Equal(t, "Song 3", p.currentSong.Value.(*Song).Title, "expected 'Song 3' to be playing")
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 3", p.State().Song.Title, "expected 'Song 3' to be playing")
 }
+
 func TestPlayAndNext(t *testing.T) {
 	p := NewPlaylist().(*playlist)
 
@@ -129,4 +150,460 @@ func TestDeleteSong(t *testing.T) {
 	err = p.DeleteSong("Song 1")
 	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorEmptyTitleSong, err)
 	assert.Equal(t, 1, p.songs.Len(), "expected playlist to still have 1 song after failed addition")
+
+	// only the first entry of a song added twice is deleted
+	p.Stop()
+	p.AddSong("Song 2", 10*time.Second)
+	p.AddSong("Song 1", 10*time.Second)
+
+	err = p.DeleteSong("Song 1")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, 2, p.songs.Len(), "expected playlist to have 2 songs")
+	assert.Equal(t, "Song 1", p.songs.Back().Value.(*Song).Title, "expected the second 'Song 1' to stay")
+}
+
+func TestPauseAndResume(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+
+	err := p.Play()
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	clock.BlockUntil(1)
+	clock.Advance(400 * time.Millisecond)
+	err = p.Pause()
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, 400*time.Millisecond, p.Position(), "expected position to be 400ms")
+
+	// the position must not move while paused
+	clock.BlockUntil(0)
+	clock.Advance(800 * time.Millisecond)
+	assert.Equal(t, 400*time.Millisecond, p.Position(), "expected position not to change while paused")
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to stay current while paused")
+
+	err = p.Play()
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	// only the remaining part of 'Song 1' is played after resume
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to be playing")
+	assert.Equal(t, 900*time.Millisecond, p.Position(), "expected position to be 900ms")
+
+	clock.Advance(100 * time.Millisecond)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing")
+	assert.Equal(t, time.Duration(0), p.Position(), "expected 'Song 2' to start from the beginning")
+}
+
+func TestSeek(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	err := p.Seek(time.Second)
+	assert.Equal(t, ErrorEmptyPlaylist, err, "expected error %v, but get: %v", ErrorEmptyPlaylist, err)
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+
+	// check seek beyond the song duration
+	err = p.Seek(2 * time.Second)
+	var rangeErr *SeekOutOfRangeError
+	assert.ErrorAs(t, err, &rangeErr, "expected SeekOutOfRangeError, but get: %v", err)
+
+	err = p.Seek(-time.Second)
+	assert.ErrorAs(t, err, &rangeErr, "expected SeekOutOfRangeError, but get: %v", err)
+
+	// check the rejected seek does not choose the current song
+	assert.Nil(t, p.State().Song, "expected no current song after the rejected seek")
+
+	// check seek while paused only moves the cursor
+	p.Play()
+	p.Pause()
+	err = p.Seek(700 * time.Millisecond)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, 700*time.Millisecond, p.Position(), "expected position to be 700ms")
+
+	clock.BlockUntil(0)
+	clock.Advance(400 * time.Millisecond)
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to stay current while paused")
+
+	// check seek while playing plays only the remaining part
+	p.Play()
+	err = p.Seek(800 * time.Millisecond)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+
+	clock.BlockUntil(1)
+	clock.Advance(200 * time.Millisecond)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing")
+}
+
+func TestState(t *testing.T) {
+	p := NewPlaylist().(*playlist)
+
+	state := p.State()
+	assert.Equal(t, StatusStopped, state.Status, "expected player to be stopped")
+	assert.Nil(t, state.Song, "expected no current song")
+	assert.Equal(t, -1, state.Index, "expected index to be -1")
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+
+	p.Play()
+	p.Next()
+	state = p.State()
+	assert.Equal(t, StatusPlaying, state.Status, "expected player to be playing")
+	assert.Equal(t, "Song 2", state.Song.Title, "expected 'Song 2' to be current")
+	assert.Equal(t, 1, state.Index, "expected index to be 1")
+
+	p.Pause()
+	p.Seek(100 * time.Second)
+	state = p.State()
+	assert.Equal(t, StatusPaused, state.Status, "expected player to be paused")
+	assert.Equal(t, 100*time.Second, state.Elapsed, "expected elapsed to be 100s")
+	assert.Equal(t, 50*time.Second, state.Remaining, "expected remaining to be 50s")
+}
+
+func TestStop(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	err := p.Stop()
+	assert.Equal(t, ErrorNotPlayingPlaylist, err, "expected error %v, but get: %v", ErrorNotPlayingPlaylist, err)
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+
+	p.Play()
+	clock.BlockUntil(1)
+	clock.Advance(300 * time.Millisecond)
+	err = p.Stop()
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, StatusStopped, p.State().Status, "expected player to be stopped")
+	assert.Equal(t, time.Duration(0), p.Position(), "expected position to be rewound")
+
+	// the playback goroutine must not advance the stopped player
+	clock.BlockUntil(0)
+	clock.Advance(900 * time.Millisecond)
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to stay current")
+
+	// the stopped song can be deleted, the cursor moves to the next one
+	err = p.DeleteSong("Song 1")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be current")
+
+	// a paused song is still playing and cannot be deleted
+	p.Play()
+	p.Pause()
+	err = p.DeleteSong("Song 2")
+	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorPlayingSong, err)
+}
+
+func TestRepeatMode(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	err := p.SetRepeatMode(RepeatMode(10))
+	assert.Equal(t, ErrorNotValidRepeatMode, err, "expected error %v, but get: %v", ErrorNotValidRepeatMode, err)
+
+	p.AddSong("Song 1", 500*time.Millisecond)
+	p.AddSong("Song 2", 500*time.Millisecond)
+
+	// check repeat-one replays the current song
+	p.SetRepeatMode(RepeatOne)
+	p.Play()
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to be replayed")
+	assert.Equal(t, StatusPlaying, p.State().Status, "expected player to be playing")
+
+	// check repeat-off stops after the last song
+	p.SetRepeatMode(RepeatOff)
+	p.Next()
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	clock.BlockUntil(0)
+	assert.Eventually(t, func() bool {
+		return p.State().Status == StatusStopped
+	}, time.Second, time.Millisecond, "expected player to be stopped")
+
+	state := p.State()
+	assert.Equal(t, RepeatOff, state.Repeat, "expected repeat mode to be off")
+	assert.Equal(t, "Song 1", state.Song.Title, "expected the cursor to return to 'Song 1'")
+
+	// check Next and Prev do not wrap around
+	p.Prev()
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to stay current")
+	p.Next()
+	p.Next()
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected the cursor to return to 'Song 1'")
+}
+
+func TestRestore(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+
+	err := p.Restore("Song 3", 0)
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	err = p.Restore("Song 2", 100*time.Second)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+
+	state := p.State()
+	assert.Equal(t, StatusStopped, state.Status, "expected player to stay stopped")
+	assert.Equal(t, "Song 2", state.Song.Title, "expected 'Song 2' to be current")
+	assert.Equal(t, 100*time.Second, state.Elapsed, "expected elapsed to be 100s")
+
+	// check the position outside of the song is reset
+	err = p.Restore("Song 1", 200*time.Second)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, time.Duration(0), p.Position(), "expected position to be reset")
+
+	// the entries of the same song are restored by their places
+	p.AddSong("Song 1", 150*time.Second)
+	err = p.RestoreAt(2, 50*time.Second)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	state = p.State()
+	assert.Equal(t, 2, state.Index, "expected the last entry to be current")
+	assert.Equal(t, 50*time.Second, state.Elapsed, "expected elapsed to be 50s")
+
+	err = p.RestoreAt(3, 0)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+
+	p.Play()
+	err = p.Restore("Song 2", 0)
+	assert.Equal(t, ErrorPlayingPlaylist, err, "expected error %v, but get: %v", ErrorPlayingPlaylist, err)
+}
+
+func titles(p *playlist) []string {
+	var titles []string
+	for _, song := range p.Songs() {
+		titles = append(titles, song.Title)
+	}
+	return titles
+}
+
+func TestInsertSong(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 3", 1*time.Second)
+
+	err := p.InsertSong("Song 2", 1*time.Second, 1)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+
+	err = p.InsertSong("Song 0", 1*time.Second, 0)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+
+	err = p.InsertSong("Song 4", 1*time.Second, 4)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 0", "Song 1", "Song 2", "Song 3", "Song 4"}, titles(p), "expected songs to be inserted at their indexes")
+
+	err = p.InsertSong("Song 6", 1*time.Second, 6)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+
+	err = p.InsertSong("Song 6", 1*time.Second, -1)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+
+	err = p.InsertSong("", 1*time.Second, 0)
+	assert.Equal(t, ErrorEmptyTitleSong, err, "expected error %v, but get: %v", ErrorEmptyTitleSong, err)
+}
+
+func TestMoveSong(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+	p.AddSong("Song 3", 1*time.Second)
+	p.AddSong("Song 4", 1*time.Second)
+
+	p.Play()
+	clock.BlockUntil(1)
+
+	err := p.MoveSong("Song 1", 2)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 2", "Song 3", "Song 1", "Song 4"}, titles(p), "expected 'Song 1' to be moved to index 2")
+
+	// the current song is still playing at its new place
+	state := p.State()
+	assert.Equal(t, "Song 1", state.Song.Title, "expected 'Song 1' to be playing")
+	assert.Equal(t, 2, state.Index, "expected index to be 2")
+
+	err = p.MoveSong("Song 4", 0)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 4", "Song 2", "Song 3", "Song 1"}, titles(p), "expected 'Song 4' to be moved to index 0")
+
+	// the next song follows the new order
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 4", p.State().Song.Title, "expected 'Song 4' to be playing")
+
+	err = p.MoveSong("Song 5", 0)
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	err = p.MoveSong("Song 1", 4)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+}
+
+func TestMoveAtDeleteAt(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+	p.AddSong("Song 1", 1*time.Second)
+
+	// the entries are addressed by their places, not by their titles
+	err := p.MoveAt(2, 0)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	err = p.MoveAt(0, 1)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 1", "Song 2"}, titles(p), "expected the second 'Song 1' to be moved")
+
+	err = p.DeleteAt(2)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 1"}, titles(p), "expected 'Song 2' to be deleted")
+
+	err = p.MoveAt(2, 0)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+	err = p.MoveAt(0, 2)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+	err = p.DeleteAt(2)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+
+	p.Play()
+	err = p.DeleteAt(0)
+	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorPlayingSong, err)
+}
+
+func TestSwapSongs(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+	p.AddSong("Song 3", 1*time.Second)
+	p.AddSong("Song 4", 1*time.Second)
+
+	err := p.SwapSongs("Song 1", "Song 3")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 3", "Song 2", "Song 1", "Song 4"}, titles(p), "expected 'Song 1' and 'Song 3' to be swapped")
+
+	// neighbours in both directions
+	err = p.SwapSongs("Song 1", "Song 4")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 3", "Song 2", "Song 4", "Song 1"}, titles(p), "expected 'Song 1' and 'Song 4' to be swapped")
+
+	err = p.SwapSongs("Song 2", "Song 3")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song 2", "Song 3", "Song 4", "Song 1"}, titles(p), "expected 'Song 2' and 'Song 3' to be swapped")
+
+	err = p.SwapSongs("Song 2", "Song 5")
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+}
+
+func TestPlaySongByTitle(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	err := p.PlaySongByTitle("Song 1")
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+	p.AddSong("Song 3", 1*time.Second)
+
+	// a stopped player starts playing the chosen song
+	err = p.PlaySongByTitle("Song 2")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	state := p.State()
+	assert.Equal(t, "Song 2", state.Song.Title, "expected 'Song 2' to be playing")
+	assert.Equal(t, StatusPlaying, state.Status, "expected player to be playing")
+
+	// the jump restarts the song and the old timer does not fire
+	clock.BlockUntil(1)
+	clock.Advance(600 * time.Millisecond)
+	err = p.PlaySongByTitle("Song 1")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, time.Duration(0), p.Position(), "expected position to be rewound")
+
+	clock.BlockUntil(1)
+	clock.Advance(600 * time.Millisecond)
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to be playing")
+
+	// the playback goes on from the chosen song
+	clock.Advance(400 * time.Millisecond)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing")
+}
+
+func TestPlayAt(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	err := p.PlayAt(0)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.AddSong("Song 3", 150*time.Second)
+
+	p.Play()
+	p.Pause()
+
+	// a paused player is resumed with the chosen song
+	err = p.PlayAt(2)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	state := p.State()
+	assert.Equal(t, "Song 3", state.Song.Title, "expected 'Song 3' to be playing")
+	assert.Equal(t, 2, state.Index, "expected the index of 'Song 3'")
+	assert.Equal(t, StatusPlaying, state.Status, "expected player to be playing")
+
+	err = p.PlayAt(3)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+	err = p.PlayAt(-1)
+	assert.Equal(t, ErrorNotValidIndex, err, "expected error %v, but get: %v", ErrorNotValidIndex, err)
+}
+
+func TestSongsByID(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	// two library songs may share a title
+	p.AddLibrarySong(Song{ID: 1, Title: "Song", Duration: 150 * time.Second})
+	p.AddLibrarySong(Song{ID: 2, Title: "Song", Duration: 200 * time.Second})
+	p.InsertLibrarySong(Song{ID: 1, Title: "Song", Duration: 150 * time.Second}, 0)
+
+	err := p.UpdateLibrarySong(Song{ID: 2, Title: "Song 2", Duration: 210 * time.Second, Artist: "Artist", Genre: "Rock"})
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []Song{
+		{ID: 1, Title: "Song", Duration: 150 * time.Second},
+		{ID: 1, Title: "Song", Duration: 150 * time.Second},
+		{ID: 2, Title: "Song 2", Duration: 210 * time.Second, Artist: "Artist", Genre: "Rock"},
+	}, p.Songs(), "expected only the song with ID 2 to be updated")
+
+	err = p.DeleteSongByID(2)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, []string{"Song", "Song"}, titles(p), "expected the song with ID 2 to be deleted")
+
+	err = p.DeleteSongByID(2)
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+	err = p.UpdateLibrarySong(Song{ID: 3, Title: "Song 3", Duration: 150 * time.Second})
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	// the entries carry the metadata of the library song
+	tags := map[string]string{"mood": "calm"}
+	p.AddLibrarySong(Song{ID: 3, Title: "Song", Duration: 150 * time.Second, Artist: "Artist", Album: "Album", Year: 2001, Tags: tags})
+	tags["mood"] = "loud"
+
+	err = p.RestoreByID(3, 30*time.Second)
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	err = p.RestoreByID(4, 0)
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	p.Play()
+	state := p.State()
+	assert.Equal(t, 3, state.Song.ID, "expected the ID of the current song")
+	assert.Equal(t, 30*time.Second, state.Elapsed, "expected the position to be restored")
+	assert.Equal(t, "Artist", state.Song.Artist, "expected the artist of the current song")
+	assert.Equal(t, 2001, state.Song.Year, "expected the year of the current song")
+	assert.Equal(t, "calm", state.Song.Tags["mood"], "expected the tags to be copied")
 }
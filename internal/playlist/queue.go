@@ -0,0 +1,86 @@
+package playlist
+
+import "container/list"
+
+// The play-next queue holds songs of the playlist which are played before
+// the successor of the current song. A queued song is played from an element
+// outside of the songs list, so the stored order is never changed, and the
+// playback continues after anchor, the song that was current before the queue.
+
+// EnqueueNext puts the first entry of the song at the end of the play-next queue.
+func (p *playlist) EnqueueNext(title string) error {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	e := p.find(title)
+	if e == nil {
+		return ErrorNotFoundSong
+	}
+
+	song := e.Value.(*Song)
+	p.queue.PushBack(song)
+	p.publish(EventSongQueued, song)
+	return nil
+}
+
+// Queue returns copies of the songs waiting in the play-next queue.
+func (p *playlist) Queue() []Song {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	songs := make([]Song, 0, p.queue.Len())
+	for e := p.queue.Front(); e != nil; e = e.Next() {
+		songs = append(songs, *e.Value.(*Song))
+	}
+	return songs
+}
+
+// ClearQueue drops the waiting songs. The song already played from the
+// queue is not stopped.
+func (p *playlist) ClearQueue() {
+	p.playbackMutex.Lock()
+	defer p.playbackMutex.Unlock()
+
+	p.queue.Init()
+}
+
+// upNext returns the song played after the current one: the head of the
+// queue or the successor in the play order. The head is taken off the queue,
+// so the caller must switch to it. It must be called with playbackMutex held.
+func (p *playlist) upNext() *list.Element {
+	if front := p.queue.Front(); front != nil {
+		p.anchor = p.cursor()
+		p.queued = &list.Element{Value: p.queue.Remove(front)}
+		return p.queued
+	}
+
+	if p.isQueued() && p.anchor == nil {
+		return p.firstElement()
+	}
+	return p.nextElement(p.cursor())
+}
+
+// cursor returns the current element of the songs list, the anchor while a
+// queued song is played. It must be called with playbackMutex held.
+func (p *playlist) cursor() *list.Element {
+	if p.isQueued() {
+		return p.anchor
+	}
+	return p.currentSong
+}
+
+func (p *playlist) isQueued() bool {
+	return p.currentSong != nil && p.currentSong == p.queued
+}
+
+// dequeue drops all the queued entries of the song. It must be called with
+// playbackMutex held.
+func (p *playlist) dequeue(song *Song) {
+	for e := p.queue.Front(); e != nil; {
+		next := e.Next()
+		if e.Value.(*Song) == song {
+			p.queue.Remove(e)
+		}
+		e = next
+	}
+}
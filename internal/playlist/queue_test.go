@@ -0,0 +1,121 @@
+package playlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueNext(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	for _, title := range []string{"Song 1", "Song 2", "Song 3", "Song 4"} {
+		p.AddSong(title, 150*time.Second)
+	}
+
+	p.Play()
+	err := p.EnqueueNext("Song 4")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	err = p.EnqueueNext("Song 3")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+
+	err = p.EnqueueNext("Song 5")
+	assert.Equal(t, ErrorNotFoundSong, err, "expected error %v, but get: %v", ErrorNotFoundSong, err)
+
+	queue := p.Queue()
+	assert.Len(t, queue, 2, "expected two songs in the queue")
+	assert.Equal(t, "Song 4", queue[0].Title, "expected 'Song 4' to be the first in the queue")
+
+	// the queued songs are played first, then the playlist goes on after 'Song 1'
+	p.Next()
+	state := p.State()
+	assert.Equal(t, "Song 4", state.Song.Title, "expected 'Song 4' to be playing")
+	assert.Equal(t, -1, state.Index, "expected no index for a queued song")
+	assert.Equal(t, []string{"Song 4", "Song 3", "Song 2", "Song 3"}, playOrder(p, 4), "expected the queue before the play order")
+	assert.Empty(t, p.Queue(), "expected the queue to be empty")
+
+	// the stored order is not changed
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 3", "Song 4"}, titles(p), "expected the stored order to stay")
+}
+
+func TestQueueAutoAdvance(t *testing.T) {
+	p, clock := newTestPlaylist()
+
+	p.AddSong("Song 1", 1*time.Second)
+	p.AddSong("Song 2", 1*time.Second)
+	p.AddSong("Song 3", 1*time.Second)
+
+	p.Play()
+	p.EnqueueNext("Song 3")
+
+	clock.BlockUntil(1)
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 3", p.State().Song.Title, "expected the queued 'Song 3' to be playing")
+
+	// Prev returns to the song the queued one was played after
+	p.Prev()
+	assert.Equal(t, "Song 1", p.State().Song.Title, "expected 'Song 1' to be playing")
+
+	p.EnqueueNext("Song 3")
+	clock.BlockUntil(1)
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	clock.Advance(1 * time.Second)
+	clock.BlockUntil(1)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing after the queue")
+}
+
+func TestClearQueue(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.AddSong("Song 3", 150*time.Second)
+
+	p.Play()
+	p.EnqueueNext("Song 3")
+	p.EnqueueNext("Song 2")
+
+	// a deleted song leaves the queue
+	p.DeleteSong("Song 3")
+	assert.Equal(t, []Song{{Title: "Song 2", Duration: 150 * time.Second}}, p.Queue(), "expected only 'Song 2' in the queue")
+
+	p.ClearQueue()
+	assert.Empty(t, p.Queue(), "expected the queue to be empty")
+
+	p.Next()
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be playing")
+}
+
+func TestDeleteQueuedPlayingSong(t *testing.T) {
+	p, _ := newTestPlaylist()
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.AddSong("Song 3", 150*time.Second)
+
+	p.Play()
+	p.EnqueueNext("Song 3")
+	p.Next()
+	assert.Equal(t, "Song 3", p.State().Song.Title, "expected the queued 'Song 3' to be playing")
+
+	// the queued song is playing, so its entry cannot be deleted
+	err := p.DeleteSong("Song 3")
+	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorPlayingSong, err)
+	err = p.DeleteAt(2)
+	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorPlayingSong, err)
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 3"}, titles(p), "expected the songs to stay")
+
+	p.Pause()
+	err = p.DeleteSong("Song 3")
+	assert.Equal(t, ErrorPlayingSong, err, "expected error %v, but get: %v", ErrorPlayingSong, err)
+
+	// the stopped one is deleted and the player goes on after 'Song 1'
+	p.Stop()
+	err = p.DeleteSong("Song 3")
+	assert.NoError(t, err, "expected no error, but get: %v", err)
+	assert.Equal(t, "Song 2", p.State().Song.Title, "expected 'Song 2' to be the current song")
+	assert.Equal(t, []string{"Song 1", "Song 2"}, titles(p), "expected 'Song 3' to be deleted")
+}
@@ -0,0 +1,80 @@
+package playlist
+
+import (
+	"container/list"
+	"math/rand"
+)
+
+// shuffleOrder is a permutation of the songs list which Next and Prev walk
+// instead of the stored order. The list itself is never reordered.
+type shuffleOrder struct {
+	seed  int64
+	rng   *rand.Rand
+	order []*list.Element
+}
+
+// newShuffleOrder shuffles the songs with the seed and rotates the
+// permutation to begin at start, so the songs after start are played in the
+// order the seed gives. A nil start leaves the permutation as it is.
+func newShuffleOrder(songs *list.List, seed int64, start *list.Element) *shuffleOrder {
+	s := &shuffleOrder{
+		seed:  seed,
+		rng:   rand.New(rand.NewSource(seed)),
+		order: make([]*list.Element, 0, songs.Len()),
+	}
+	for e := songs.Front(); e != nil; e = e.Next() {
+		s.order = append(s.order, e)
+	}
+	s.rng.Shuffle(len(s.order), func(i, j int) {
+		s.order[i], s.order[j] = s.order[j], s.order[i]
+	})
+
+	if i := s.index(start); i > 0 {
+		s.order = append(s.order[i:], s.order[:i]...)
+	}
+	return s
+}
+
+func (s *shuffleOrder) index(e *list.Element) int {
+	for i, el := range s.order {
+		if el == e {
+			return i
+		}
+	}
+	return -1
+}
+
+// next and prev return nil at the ends of the permutation, the same way
+// list.Element does at the ends of the list.
+func (s *shuffleOrder) next(e *list.Element) *list.Element {
+	i := s.index(e) + 1
+	if i >= len(s.order) {
+		return nil
+	}
+	return s.order[i]
+}
+
+func (s *shuffleOrder) prev(e *list.Element) *list.Element {
+	i := s.index(e) - 1
+	if i < 0 {
+		return nil
+	}
+	return s.order[i]
+}
+
+// insert slots e at a random place after current, so a new song is played
+// in the current round of the shuffle.
+func (s *shuffleOrder) insert(e *list.Element, current *list.Element) {
+	from := s.index(current) + 1
+	i := from + s.rng.Intn(len(s.order)-from+1)
+
+	s.order = append(s.order, nil)
+	copy(s.order[i+1:], s.order[i:])
+	s.order[i] = e
+}
+
+func (s *shuffleOrder) remove(e *list.Element) {
+	if i := s.index(e); i >= 0 {
+		s.order = append(s.order[:i], s.order[i+1:]...)
+	}
+}
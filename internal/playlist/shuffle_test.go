@@ -0,0 +1,110 @@
+package playlist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func playOrder(p *playlist, n int) []string {
+	var titles []string
+	for i := 0; i < n; i++ {
+		titles = append(titles, p.currentSong.Value.(*Song).Title)
+		p.Next()
+	}
+	return titles
+}
+
+func TestShuffle(t *testing.T) {
+	p := NewPlaylist().(*playlist)
+
+	for _, title := range []string{"Song 1", "Song 2", "Song 3", "Song 4", "Song 5"} {
+		p.AddSong(title, 150*time.Second)
+	}
+
+	p.SetShuffle(true, 42)
+	p.Play()
+	first := playOrder(p, 5)
+	assert.Equal(t, []string{"Song 3", "Song 4", "Song 5", "Song 1", "Song 2"}, first, "expected the order given by seed 42")
+
+	// check the same seed gives the same order
+	p.Stop()
+	p.currentSong = nil
+	p.SetShuffle(true, 42)
+	p.Play()
+	assert.Equal(t, first, playOrder(p, 5), "expected the same order for the same seed")
+
+	// check the stored order is not changed
+	var stored []string
+	for e := p.songs.Front(); e != nil; e = e.Next() {
+		stored = append(stored, e.Value.(*Song).Title)
+	}
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 3", "Song 4", "Song 5"}, stored, "expected the stored order to be kept")
+
+	// check Prev walks the permutation back
+	current := p.currentSong.Value.(*Song).Title
+	p.Next()
+	p.Prev()
+	assert.Equal(t, current, p.currentSong.Value.(*Song).Title, "expected Prev to return to %q", current)
+
+	p.SetShuffle(false, 0)
+	assert.False(t, p.State().Shuffle, "expected shuffle to be off")
+}
+
+func TestShuffleAddAndDelete(t *testing.T) {
+	p := NewPlaylist().(*playlist)
+
+	p.AddSong("Song 1", 150*time.Second)
+	p.AddSong("Song 2", 150*time.Second)
+	p.SetShuffle(true, 7)
+	p.Play()
+
+	p.AddSong("Song 3", 150*time.Second)
+	assert.Len(t, p.shuffle.order, 3, "expected the new song to be slotted in")
+	assert.Greater(t, p.shuffle.index(p.songs.Back()), p.shuffle.index(p.currentSong), "expected the new song to be played after the current one")
+
+	p.Next()
+	p.DeleteSong("Song 1")
+	p.DeleteSong("Song 2")
+	p.DeleteSong("Song 3")
+	assert.Len(t, p.shuffle.order, 1, "expected the deleted songs to leave the permutation")
+}
+
+func TestShuffleStartsAtCurrentSong(t *testing.T) {
+	titles := []string{"Song 1", "Song 2", "Song 3", "Song 4", "Song 5"}
+
+	// seed 0 gives the same order as any other seed
+	p := NewPlaylist().(*playlist)
+	for _, title := range titles {
+		p.AddSong(title, 150*time.Second)
+	}
+	p.SetShuffle(true, 0)
+	p.Play()
+	order := playOrder(p, 5)
+
+	other := NewPlaylist().(*playlist)
+	for _, title := range titles {
+		other.AddSong(title, 150*time.Second)
+	}
+	other.SetShuffle(true, 0)
+	other.Play()
+	assert.Equal(t, order, playOrder(other, 5), "expected the same order for seed 0")
+
+	// shuffle turned on during the playback goes on after the current song
+	p.Stop()
+	p.SetShuffle(false, 0)
+	p.PlaySongByTitle(order[2])
+	p.SetShuffle(true, 0)
+	assert.Equal(t, p.currentSong, p.shuffle.order[0], "expected the permutation to start at the current song")
+
+	rotated := append(append([]string{}, order[2:]...), order[:2]...)
+	assert.Equal(t, rotated, playOrder(p, 5), "expected the order of the seed from the current song")
+
+	// without repeat the round from the current song plays every song
+	p.SetRepeatMode(RepeatOff)
+	p.PlaySongByTitle(order[2])
+	playOrder(p, 4)
+	assert.Equal(t, StatusPlaying, p.State().Status, "expected the last song of the round to be playing")
+	assert.Equal(t, order[1], p.State().Song.Title, "expected the round to end before the current song")
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"MusicPlayerProject/internal/data"
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newMemoryController builds a controller over the in-memory SongDB with
+// the songs of a minute each in the library, so the database steps behave
+// as they do in production.
+func newMemoryController(t *testing.T, db db_song.SongDB, titles ...string) IPlaylistController {
+	ctx := context.Background()
+	c, err := NewPlaylistController(ctx, db)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	for _, title := range titles {
+		_, err = c.CreateSong(ctx, &data.Song{Title: title, Duration: time.Minute})
+		assert.NoError(t, err, "expected no error, but got: %v", err)
+	}
+	return c
+}
+
+func playlistTitles(t *testing.T, c IPlaylistController, id int) []string {
+	playlists, err := c.ListPlaylists(context.Background())
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	var titles []string
+	for _, p := range playlists {
+		if p.ID != id {
+			continue
+		}
+		for _, song := range p.Songs {
+			titles = append(titles, song.Title)
+		}
+	}
+	return titles
+}
+
+func TestMemoryDB(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, ctx context.Context, db db_song.SongDB)
+	}{
+		{"duplicate song", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db)
+
+			song := &data.Song{Title: "Song 1", Duration: time.Minute, Artist: "Artist"}
+			_, err := c.CreateSong(ctx, song)
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			_, err = c.CreateSong(ctx, song)
+			assert.ErrorIs(t, err, ErrorSongExised, "expected ErrorSongExised, but got: %v", err)
+
+			page, err := c.ListSongs(ctx, SongQuery{})
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.Len(t, page.Songs, 1, "expected one song in the library")
+		}},
+		{"delete song", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db, "Song 1", "Song 2")
+			id, err := c.CreatePlaylist(ctx, "Playlist 1")
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 1"))
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 2"))
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 1"))
+
+			song, err := c.GetSong(ctx, "Song 1")
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+
+			// the deleted song leaves the library and every entry of the playlists
+			assert.NoError(t, c.DeleteSongByID(ctx, song.ID))
+			_, err = c.GetSongByID(ctx, song.ID)
+			assert.Error(t, err, "expected an error for the deleted song")
+			assert.Equal(t, []string{"Song 2"}, playlistTitles(t, c, id), "expected the entries of the deleted song to be removed")
+		}},
+		{"reorder", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db, "Song 1", "Song 2", "Song 3")
+			id, err := c.CreatePlaylist(ctx, "Playlist 1")
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 1"))
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 2"))
+
+			assert.NoError(t, c.MoveSong(ctx, id, "Song 2", 0))
+			assert.NoError(t, c.InsertSong(ctx, id, "Song 3", 1))
+			assert.Equal(t, []string{"Song 2", "Song 3", "Song 1"}, playlistTitles(t, c, id), "expected the stored order")
+
+			// a failed move changes neither the database nor the player
+			err = c.MoveSong(ctx, id, "Song 2", 3)
+			assert.Error(t, err, "expected an error for a move out of the playlist")
+			assert.Equal(t, []string{"Song 2", "Song 3", "Song 1"}, playlistTitles(t, c, id), "expected the order to be kept")
+		}},
+		{"restart", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db, "Song 1", "Song 2")
+			id, err := c.CreatePlaylist(ctx, "Playlist 1")
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 1"))
+			assert.NoError(t, c.AddSongToPlaylist(ctx, id, "Song 2"))
+			assert.NoError(t, c.SwapSongs(ctx, id, "Song 1", "Song 2"))
+
+			// a restarted controller loads what the first one stored
+			c = newMemoryController(t, db)
+			assert.Equal(t, []string{"Song 2", "Song 1"}, playlistTitles(t, c, id), "expected the stored order of the playlist")
+
+			assert.NoError(t, c.PlaySong(ctx, DefaultPlaylistID))
+			state, err := c.GetPlaybackState(ctx, DefaultPlaylistID)
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.Equal(t, "Song 1", state.Song.Title, "expected the order of creation in the library")
+
+			state, err = c.GetPlaybackState(ctx, id)
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.NotNil(t, state, "expected the playback state of the loaded playlist")
+		}},
+		{"stopped cursor restart", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db, "Song 1", "Song 2", "Song 3")
+			assert.NoError(t, c.PlaySong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.StopSong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.NextSong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.NextSong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.PrevSong(ctx, DefaultPlaylistID))
+
+			// the cursor moved while stopped survives the restart
+			c = newMemoryController(t, db)
+			assert.NoError(t, c.PlaySong(ctx, DefaultPlaylistID))
+			state, err := c.GetPlaybackState(ctx, DefaultPlaylistID)
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.Equal(t, "Song 2", state.Song.Title, "expected the stored cursor")
+		}},
+		{"seek restart", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			c := newMemoryController(t, db, "Song 1", "Song 2")
+			assert.NoError(t, c.PlaySong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.NextSong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.StopSong(ctx, DefaultPlaylistID))
+			assert.NoError(t, c.SeekSong(ctx, DefaultPlaylistID, 30*time.Second))
+
+			// the position sought while stopped survives the restart
+			c = newMemoryController(t, db)
+			state, err := c.GetPlaybackState(ctx, DefaultPlaylistID)
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			assert.Equal(t, "Song 2", state.Song.Title, "expected the stored song")
+			assert.Equal(t, 30*time.Second, state.Elapsed, "expected the stored position")
+		}},
+		{"shuffle restart", func(t *testing.T, ctx context.Context, db db_song.SongDB) {
+			newMemoryController(t, db, "Song 1", "Song 2", "Song 3", "Song 4", "Song 5")
+			song, err := db.Get(ctx, "Song 4")
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+			err = db.SavePlayerState(ctx, &data.PlayerState{SongID: song.ID, RepeatMode: 0, Shuffle: true, ShuffleSeed: 0})
+			assert.NoError(t, err, "expected no error, but got: %v", err)
+
+			// the shuffle is restored from the stored song, so the round plays every song
+			c := newMemoryController(t, db)
+			assert.NoError(t, c.PlaySong(ctx, DefaultPlaylistID))
+			played := map[string]bool{}
+			for i := 0; i < 5; i++ {
+				state, err := c.GetPlaybackState(ctx, DefaultPlaylistID)
+				assert.NoError(t, err, "expected no error, but got: %v", err)
+				assert.Equal(t, playlist.StatusPlaying, state.Status, "expected the round to go on")
+				played[state.Song.Title] = true
+				assert.NoError(t, c.NextSong(ctx, DefaultPlaylistID))
+			}
+			assert.Len(t, played, 5, "expected every song in the round")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, context.Background(), db_song.NewMemorySongDB())
+		})
+	}
+}
@@ -6,66 +6,291 @@ import (
 	"MusicPlayerProject/internal/playlist"
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 )
 
 type IPlaylistController interface {
-	CreateSong(ctx context.Context, title string, duration time.Duration) (int, error)
+	CreateSong(ctx context.Context, song *data.Song) (int, error)
 	GetSong(ctx context.Context, title string) (*data.Song, error)
-	UpdateSong(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error
+	GetSongByID(ctx context.Context, id int) (*data.Song, error)
+	UpdateSong(ctx context.Context, oldTitle string, song *data.Song) (int, error)
+	UpdateSongByID(ctx context.Context, id int, song *data.Song) error
 	DeleteSong(ctx context.Context, title string) error
-	ListSongs(ctx context.Context) ([]*data.Song, error)
-	PlaySong(ctx context.Context) error
-	PauseSong(ctx context.Context) error
-	NextSong(ctx context.Context) error
-	PrevSong(ctx context.Context) error
+	DeleteSongByID(ctx context.Context, id int) error
+	ListSongs(ctx context.Context, query SongQuery) (*SongPage, error)
+	SearchSongs(ctx context.Context, query string, limit int) ([]*data.Song, error)
+
+	CreatePlaylist(ctx context.Context, name string) (int, error)
+	ListPlaylists(ctx context.Context) ([]*data.Playlist, error)
+	RenamePlaylist(ctx context.Context, id int, name string) error
+	DeletePlaylist(ctx context.Context, id int) error
+	AddSongToPlaylist(ctx context.Context, playlistID int, title string) error
+	RemoveSongFromPlaylist(ctx context.Context, playlistID int, title string) error
+	InsertSong(ctx context.Context, playlistID int, title string, index int) error
+	MoveSong(ctx context.Context, playlistID int, title string, index int) error
+	SwapSongs(ctx context.Context, playlistID int, first string, second string) error
+
+	PlaySong(ctx context.Context, playlistID int) error
+	PauseSong(ctx context.Context, playlistID int) error
+	StopSong(ctx context.Context, playlistID int) error
+	NextSong(ctx context.Context, playlistID int) error
+	PrevSong(ctx context.Context, playlistID int) error
+	PlaySongByTitle(ctx context.Context, playlistID int, title string) error
+	PlayAt(ctx context.Context, playlistID int, index int) error
+	SeekSong(ctx context.Context, playlistID int, offset time.Duration) error
+	GetPlaybackState(ctx context.Context, playlistID int) (*playlist.PlaybackState, error)
+	WatchPlayback(ctx context.Context, playlistID int) (*playlist.Subscription, error)
+	SetShuffle(ctx context.Context, playlistID int, enabled bool, seed int64) error
+	SetRepeatMode(ctx context.Context, playlistID int, mode playlist.RepeatMode) error
+	EnqueueNext(ctx context.Context, playlistID int, title string) error
+	ListQueue(ctx context.Context, playlistID int) ([]playlist.Song, error)
+	ClearQueue(ctx context.Context, playlistID int) error
 }
 
+// DefaultPlaylistID addresses the playlist of the whole library, the one
+// every created song is added to. Named playlists have positive IDs.
+const DefaultPlaylistID = 0
+
 type playlistController struct {
 	db       db_song.SongDB
 	playlist playlist.IBasePlaybackMusicPlayer
+
+	// players holds the playback engines of the named playlists by their IDs.
+	players      map[int]playlist.IBasePlaybackMusicPlayer
+	playersMutex sync.RWMutex
+
+	// txMutex runs the units of work one at a time, so the places of the
+	// entries checked by one stay valid until its in-memory steps are made
+	// or undone.
+	txMutex sync.Mutex
+
+	// stateMutex makes reading the player state and saving it one step, so
+	// a slow save of an older state does not overwrite a newer one.
+	stateMutex sync.Mutex
 }
 
-func NewPlaylistController(db db_song.SongDB) IPlaylistController {
-	playlist := playlist.NewPlaylist()
-	return &playlistController{db: db, playlist: playlist}
+// NewPlaylistController fills the playback engines with the songs stored in
+// the database and puts the cursor where it was before the restart.
+func NewPlaylistController(ctx context.Context, db db_song.SongDB) (IPlaylistController, error) {
+	c := &playlistController{
+		db:       db,
+		playlist: playlist.NewPlaylist(),
+		players:  make(map[int]playlist.IBasePlaybackMusicPlayer),
+	}
+
+	err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.loadPlaylists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	go c.persistState(c.playlist.Subscribe(watchBufferSize, playlist.DropOldest))
+	return c, nil
 }
 
+// watchBufferSize is how many events a single watcher may fall behind
+// before the oldest ones are dropped.
+const watchBufferSize = 64
+
 var (
-	ErrSongNotPlaying       = errors.New("No song is currently playing")
-	ErrSongStillPlaying     = errors.New("The song is still playing, stop it before deleting")
-	ErrorSongExised         = errors.New("The song with this title already exists in the database")
+	ErrorSongExised         = errors.New("The song with this artist, title and album already exists in the database")
 	ErrorNotFoundSongOnBase = errors.New("The song is not found on database")
 	ErrorNilPlaylist        = errors.New("The playlist cannot be nil")
+	ErrorNotFoundPlaylist   = errors.New("The playlist is not found")
+	ErrorEmptyPlaylistName  = errors.New("The name of the playlist cannot be empty")
+	ErrorPlaylistExists     = errors.New("The playlist with this name already exists")
+	ErrorPlayingInPlaylist  = errors.New("The song is currently playing in a playlist, stop it before deleting")
+	ErrorLibraryOrder       = errors.New("The songs of the library playlist keep the order they are created in")
 )
 
-func (c *playlistController) CreateSong(ctx context.Context, title string, duration time.Duration) (int, error) {
-	if title == "" {
-		return 0, playlist.ErrorEmptyTitleSong
+func (c *playlistController) load(ctx context.Context) error {
+	songs, err := c.db.List(ctx)
+	if err != nil {
+		return err
 	}
-	if duration <= 0 {
-		return 0, playlist.ErrorNotValidDurationSong
+
+	for _, song := range songs {
+		err = c.playlist.AddLibrarySong(libraryEntry(song))
+		if err != nil {
+			return err
+		}
 	}
 
-	song, err := c.db.Get(ctx, title)
+	state, err := c.db.GetPlayerState(ctx)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	if song != nil {
-		return 0, ErrorSongExised
+	if state == nil {
+		return nil
 	}
 
-	song = &data.Song{
-		Title:    title,
-		Duration: duration,
+	err = c.playlist.SetRepeatMode(playlist.RepeatMode(state.RepeatMode))
+	if err != nil {
+		return err
+	}
+
+	for _, song := range songs {
+		if song.ID == state.SongID {
+			err = c.playlist.RestoreByID(song.ID, state.Position)
+			if err != nil {
+				return err
+			}
+			break
+		}
 	}
 
-	id, err := c.db.Create(ctx, song)
+	// the shuffle starts at the restored song, the songs after it come in
+	// the order they did before the restart
+	if state.Shuffle {
+		c.playlist.SetShuffle(true, state.ShuffleSeed)
+	}
+	return nil
+}
+
+func (c *playlistController) loadPlaylists(ctx context.Context) error {
+	playlists, err := c.db.ListPlaylists(ctx)
 	if err != nil {
-		return 0, err
+		return err
+	}
+
+	for _, p := range playlists {
+		songs, err := c.db.ListPlaylistSongs(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+
+		player := playlist.NewPlaylist()
+		for _, song := range songs {
+			err = player.AddLibrarySong(libraryEntry(song))
+			if err != nil {
+				return err
+			}
+		}
+		c.players[p.ID] = player
 	}
+	return nil
+}
+
+// player returns the playback engine of the playlist.
+func (c *playlistController) player(playlistID int) (playlist.IBasePlaybackMusicPlayer, error) {
+	if playlistID == DefaultPlaylistID {
+		if c.playlist == nil {
+			return nil, ErrorNilPlaylist
+		}
+		return c.playlist, nil
+	}
+
+	c.playersMutex.RLock()
+	defer c.playersMutex.RUnlock()
+
+	player, ok := c.players[playlistID]
+	if !ok {
+		return nil, ErrorNotFoundPlaylist
+	}
+	return player, nil
+}
+
+// namedPlayers returns the playback engines of all named playlists.
+func (c *playlistController) namedPlayers() []playlist.IBasePlaybackMusicPlayer {
+	c.playersMutex.RLock()
+	defer c.playersMutex.RUnlock()
+
+	players := make([]playlist.IBasePlaybackMusicPlayer, 0, len(c.players))
+	for _, player := range c.players {
+		players = append(players, player)
+	}
+	return players
+}
 
-	err = c.playlist.AddSong(title, duration)
+// persistState saves the player state every time the current song or its
+// position changes, including the changes made by the playback itself.
+func (c *playlistController) persistState(subscription *playlist.Subscription) {
+	for event := range subscription.Events() {
+		switch event.Type {
+		case playlist.EventSongStarted, playlist.EventPaused, playlist.EventStopped,
+			playlist.EventSkipped, playlist.EventSongRemoved:
+			err := c.saveState(context.Background())
+			if err != nil {
+				slog.Error("Failed to save the player state", "error", err)
+			}
+		}
+	}
+}
+
+func (c *playlistController) saveState(ctx context.Context) error {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	state := c.playlist.State()
+
+	playerState := &data.PlayerState{
+		Position:    state.Elapsed,
+		RepeatMode:  int(state.Repeat),
+		Shuffle:     state.Shuffle,
+		ShuffleSeed: state.ShuffleSeed,
+	}
+
+	if state.Song != nil {
+		playerState.SongID = state.Song.ID
+	}
+
+	return c.db.SavePlayerState(ctx, playerState)
+}
+
+// libraryEntry returns the entry of the library song for the playback engines.
+func libraryEntry(song *data.Song) playlist.Song {
+	return playlist.Song{
+		ID:          song.ID,
+		Title:       song.Title,
+		Duration:    song.Duration,
+		Artist:      song.Artist,
+		Album:       song.Album,
+		AlbumArtist: song.AlbumArtist,
+		TrackNumber: song.TrackNumber,
+		DiscNumber:  song.DiscNumber,
+		Genre:       song.Genre,
+		Year:        song.Year,
+		Tags:        song.Tags,
+	}
+}
+
+// CreateSong adds the song to the library and to the end of its playlist. A
+// song with the same artist, title and album must not exist.
+func (c *playlistController) CreateSong(ctx context.Context, song *data.Song) (int, error) {
+	if song.Title == "" {
+		return 0, playlist.ErrorEmptyTitleSong
+	}
+	if song.Duration <= 0 {
+		return 0, playlist.ErrorNotValidDurationSong
+	}
+
+	var id int
+	err := c.inTx(ctx, func(u *unitOfWork) error {
+		existing, err := u.tx.Find(ctx, song.Artist, song.Title, song.Album)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return ErrorSongExised
+		}
+
+		id, err = u.tx.Create(ctx, song)
+		if err != nil {
+			return err
+		}
+
+		entry := libraryEntry(song)
+		entry.ID = id
+		u.addEntry(c.playlist, entry)
+		return nil
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -84,93 +309,379 @@ func (c *playlistController) GetSong(ctx context.Context, title string) (*data.S
 	return song, nil
 }
 
-func (c *playlistController) UpdateSong(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error {
-	if newTitle == "" {
+func (c *playlistController) GetSongByID(ctx context.Context, id int) (*data.Song, error) {
+	song, err := c.db.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if song == nil {
+		return nil, playlist.ErrorNotFoundSong
+	}
+	return song, nil
+}
+
+// UpdateSong gives the first song with the old title the title, the
+// duration and the metadata of song and returns its ID.
+func (c *playlistController) UpdateSong(ctx context.Context, oldTitle string, song *data.Song) (int, error) {
+	err := checkSong(song)
+	if err != nil {
+		return 0, err
+	}
+
+	var id int
+	err = c.inTx(ctx, func(u *unitOfWork) error {
+		stored, err := u.tx.Get(ctx, oldTitle)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			return ErrorNotFoundSongOnBase
+		}
+
+		err = checkSongFree(ctx, u.tx, stored.ID, song)
+		if err != nil {
+			return err
+		}
+
+		err = u.tx.Update(ctx, oldTitle, song)
+		if err != nil {
+			return err
+		}
+
+		id = stored.ID
+		c.updateEntries(u, id, song)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateSongByID is UpdateSong for the song with the ID.
+func (c *playlistController) UpdateSongByID(ctx context.Context, id int, song *data.Song) error {
+	err := checkSong(song)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		stored, err := u.tx.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			return ErrorNotFoundSongOnBase
+		}
+
+		err = checkSongFree(ctx, u.tx, id, song)
+		if err != nil {
+			return err
+		}
+
+		err = u.tx.UpdateByID(ctx, id, song)
+		if err != nil {
+			return err
+		}
+
+		c.updateEntries(u, id, song)
+		return nil
+	})
+}
+
+func checkSong(song *data.Song) error {
+	if song.Title == "" {
 		return playlist.ErrorEmptyTitleSong
 	}
-	if duration <= 0 {
+	if song.Duration <= 0 {
 		return playlist.ErrorNotValidDurationSong
 	}
+	return nil
+}
 
-	song, err := c.db.Get(ctx, oldTitle)
+// checkSongFree returns ErrorSongExised if the new artist, title and album
+// of the song with the ID are the same as of another song of the library.
+func checkSongFree(ctx context.Context, db db_song.SongDB, id int, song *data.Song) error {
+	existing, err := db.Find(ctx, song.Artist, song.Title, song.Album)
 	if err != nil {
 		return err
 	}
-	if song == nil {
-		return ErrorNotFoundSongOnBase
+	if existing != nil && existing.ID != id {
+		return ErrorSongExised
 	}
+	return nil
+}
+
+// updateEntries changes the entries of the song in all playlists after the
+// commit.
+func (c *playlistController) updateEntries(u *unitOfWork, id int, song *data.Song) {
+	entry := libraryEntry(song)
+	entry.ID = id
+
+	u.afterCommit(func() error {
+		err := c.playlist.UpdateLibrarySong(entry)
+		if err != nil {
+			return err
+		}
+
+		for _, player := range c.namedPlayers() {
+			err = player.UpdateLibrarySong(entry)
+			if err != nil && !isMissingSong(err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteSong deletes the song from the library together with all its entries
+// in the playlists. Nothing is deleted while any of them is playing.
+func (c *playlistController) DeleteSong(ctx context.Context, title string) error {
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		song, err := u.tx.Get(ctx, title)
+		if err != nil {
+			return err
+		}
+		if song == nil {
+			return ErrorNotFoundSongOnBase
+		}
+
+		err = c.checkNotPlaying(song.ID)
+		if err != nil {
+			return err
+		}
+
+		// the entries in the playlists are deleted by the database cascade
+		err = u.tx.Delete(ctx, title)
+		if err != nil {
+			return err
+		}
+
+		return c.deleteAllEntries(u, song.ID)
+	})
+}
+
+// DeleteSongByID is DeleteSong for the song with the ID.
+func (c *playlistController) DeleteSongByID(ctx context.Context, id int) error {
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		song, err := u.tx.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if song == nil {
+			return ErrorNotFoundSongOnBase
+		}
+
+		err = c.checkNotPlaying(id)
+		if err != nil {
+			return err
+		}
+
+		err = u.tx.DeleteByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return c.deleteAllEntries(u, id)
+	})
+}
 
-	song, err = c.db.Get(ctx, newTitle)
+// deleteAllEntries deletes the entries of the song from all playlists.
+func (c *playlistController) deleteAllEntries(u *unitOfWork, id int) error {
+	err := u.deleteEntries(c.playlist, id)
 	if err != nil {
 		return err
 	}
-	if song != nil {
-		return ErrorSongExised
+
+	for _, player := range c.namedPlayers() {
+		err = u.deleteEntries(player, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNotPlaying returns an error if the song is playing or paused in the
+// library or in any of the named playlists.
+func (c *playlistController) checkNotPlaying(id int) error {
+	if isPlaying(c.playlist, id) {
+		return playlist.ErrorPlayingSong
+	}
+
+	c.playersMutex.RLock()
+	defer c.playersMutex.RUnlock()
+
+	for playlistID, player := range c.players {
+		if isPlaying(player, id) {
+			return fmt.Errorf("%w (playlist %d)", ErrorPlayingInPlaylist, playlistID)
+		}
 	}
+	return nil
+}
 
-	err = c.db.Update(ctx, oldTitle, newTitle, duration)
+func isPlaying(player playlist.IBasePlaybackMusicPlayer, id int) bool {
+	state := player.State()
+	return state.Status != playlist.StatusStopped && state.Song != nil && state.Song.ID == id
+}
+
+// isMissingSong reports whether the song is just not in the playlist.
+func isMissingSong(err error) bool {
+	return errors.Is(err, playlist.ErrorNotFoundSong) || errors.Is(err, playlist.ErrorEmptyPlaylist)
+}
+
+func (c *playlistController) PlaySong(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
 	if err != nil {
 		return err
 	}
+	return player.Play()
+}
 
-	err = c.playlist.UpdateSong(oldTitle, newTitle, duration)
+func (c *playlistController) PauseSong(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
 	if err != nil {
 		return err
 	}
+	return player.Pause()
+}
 
-	return nil
+func (c *playlistController) StopSong(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	return player.Stop()
 }
 
-func (c *playlistController) DeleteSong(ctx context.Context, title string) error {
-	song, err := c.db.Get(ctx, title)
+func (c *playlistController) NextSong(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
 	if err != nil {
 		return err
 	}
-	if song == nil {
-		return ErrorNotFoundSongOnBase
+	err = player.Next()
+	if err != nil {
+		return err
 	}
+	// a stopped player moves the cursor without an event persistState saves
+	return c.saveStateOf(ctx, playlistID)
+}
 
-	err = c.playlist.DeleteSong(song.Title)
+func (c *playlistController) PrevSong(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	err = player.Prev()
 	if err != nil {
 		return err
 	}
+	// a stopped player moves the cursor without an event persistState saves
+	return c.saveStateOf(ctx, playlistID)
+}
 
-	err = c.db.Delete(ctx, title)
+func (c *playlistController) PlaySongByTitle(ctx context.Context, playlistID int, title string) error {
+	player, err := c.player(playlistID)
 	if err != nil {
 		return err
 	}
+	return player.PlaySongByTitle(title)
+}
 
-	return nil
+func (c *playlistController) PlayAt(ctx context.Context, playlistID int, index int) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	return player.PlayAt(index)
 }
 
-func (c *playlistController) ListSongs(ctx context.Context) ([]*data.Song, error) {
-	return c.db.List(ctx)
+func (c *playlistController) SeekSong(ctx context.Context, playlistID int, offset time.Duration) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	err = player.Seek(offset)
+	if err != nil {
+		return err
+	}
+	return c.saveStateOf(ctx, playlistID)
 }
 
-func (c *playlistController) PlaySong(ctx context.Context) error {
-	if c.playlist == nil {
-		return ErrorNilPlaylist
+func (c *playlistController) GetPlaybackState(ctx context.Context, playlistID int) (*playlist.PlaybackState, error) {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return nil, err
 	}
-	return c.playlist.Play()
+	state := player.State()
+	return &state, nil
 }
 
-func (c *playlistController) PauseSong(ctx context.Context) error {
-	if c.playlist == nil {
-		return ErrorNilPlaylist
+func (c *playlistController) WatchPlayback(ctx context.Context, playlistID int) (*playlist.Subscription, error) {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return nil, err
 	}
-	return c.playlist.Pause()
+	return player.Subscribe(watchBufferSize, playlist.DropOldest), nil
 }
 
-func (c *playlistController) NextSong(ctx context.Context) error {
-	if c.playlist == nil {
-		return ErrorNilPlaylist
+// SetShuffle turns the shuffle mode on or off. The seed is used as it is,
+// so zero gives a reproducible order too.
+func (c *playlistController) SetShuffle(ctx context.Context, playlistID int, enabled bool, seed int64) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
 	}
-	return c.playlist.Next()
+	player.SetShuffle(enabled, seed)
+	return c.saveStateOf(ctx, playlistID)
+}
+
+func (c *playlistController) SetRepeatMode(ctx context.Context, playlistID int, mode playlist.RepeatMode) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	err = player.SetRepeatMode(mode)
+	if err != nil {
+		return err
+	}
+	return c.saveStateOf(ctx, playlistID)
+}
+
+// EnqueueNext puts the song of the playlist into its play-next queue. The
+// queue is not stored, the order of the playlist does not change.
+func (c *playlistController) EnqueueNext(ctx context.Context, playlistID int, title string) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	return player.EnqueueNext(title)
+}
+
+func (c *playlistController) ListQueue(ctx context.Context, playlistID int) ([]playlist.Song, error) {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return nil, err
+	}
+	return player.Queue(), nil
+}
+
+func (c *playlistController) ClearQueue(ctx context.Context, playlistID int) error {
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+	player.ClearQueue()
+	return nil
 }
 
-func (c *playlistController) PrevSong(ctx context.Context) error {
-	if c.playlist == nil {
-		return ErrorNilPlaylist
+// saveStateOf saves the player state of the default playlist, the state of
+// named playlists is not kept between restarts.
+func (c *playlistController) saveStateOf(ctx context.Context, playlistID int) error {
+	if playlistID != DefaultPlaylistID {
+		return nil
 	}
-	return c.playlist.Prev()
+	return c.saveState(ctx)
 }
@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"MusicPlayerProject/internal/data"
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
 	"context"
+	"encoding/base64"
+	"errors"
 	"testing"
 	"time"
 
@@ -24,8 +28,28 @@ func (m *MockSongDB) Get(ctx context.Context, title string) (*data.Song, error)
 	return args.Get(0).(*data.Song), args.Error(1)
 }
 
-func (m *MockSongDB) Update(ctx context.Context, oldTitle string, newTitle string, duration time.Duration) error {
-	args := m.Called(ctx, oldTitle, newTitle, duration)
+func (m *MockSongDB) Find(ctx context.Context, artist string, title string, album string) (*data.Song, error) {
+	args := m.Called(ctx, artist, title, album)
+	return args.Get(0).(*data.Song), args.Error(1)
+}
+
+func (m *MockSongDB) GetByID(ctx context.Context, id int) (*data.Song, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*data.Song), args.Error(1)
+}
+
+func (m *MockSongDB) UpdateByID(ctx context.Context, id int, song *data.Song) error {
+	args := m.Called(ctx, id, song)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) DeleteByID(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) Update(ctx context.Context, oldTitle string, song *data.Song) error {
+	args := m.Called(ctx, oldTitle, song)
 	return args.Error(0)
 }
 
@@ -39,6 +63,118 @@ func (m *MockSongDB) List(ctx context.Context) ([]*data.Song, error) {
 	return args.Get(0).([]*data.Song), args.Error(1)
 }
 
+func (m *MockSongDB) Search(ctx context.Context, query string, limit int) ([]*data.Song, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]*data.Song), args.Error(1)
+}
+
+func (m *MockSongDB) ListPage(ctx context.Context, opts data.SongListOptions) ([]*data.Song, error) {
+	args := m.Called(ctx, opts)
+	return args.Get(0).([]*data.Song), args.Error(1)
+}
+
+func (m *MockSongDB) SetOrder(ctx context.Context, ids []int) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) GetPlayerState(ctx context.Context) (*data.PlayerState, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*data.PlayerState), args.Error(1)
+}
+
+func (m *MockSongDB) SavePlayerState(ctx context.Context, state *data.PlayerState) error {
+	args := m.Called(ctx, state)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) CreatePlaylist(ctx context.Context, name string) (int, error) {
+	args := m.Called(ctx, name)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSongDB) GetPlaylist(ctx context.Context, id int) (*data.Playlist, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*data.Playlist), args.Error(1)
+}
+
+func (m *MockSongDB) ListPlaylists(ctx context.Context) ([]*data.Playlist, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*data.Playlist), args.Error(1)
+}
+
+func (m *MockSongDB) RenamePlaylist(ctx context.Context, id int, name string) error {
+	args := m.Called(ctx, id, name)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) DeletePlaylist(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) AddPlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	args := m.Called(ctx, playlistID, songID)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) RemovePlaylistSong(ctx context.Context, playlistID int, songID int) error {
+	args := m.Called(ctx, playlistID, songID)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) ListPlaylistSongs(ctx context.Context, playlistID int) ([]*data.Song, error) {
+	args := m.Called(ctx, playlistID)
+	return args.Get(0).([]*data.Song), args.Error(1)
+}
+
+func (m *MockSongDB) SetPlaylistSongs(ctx context.Context, playlistID int, songIDs []int) error {
+	args := m.Called(ctx, playlistID, songIDs)
+	return args.Error(0)
+}
+
+func (m *MockSongDB) BeginTx(ctx context.Context) (db_song.SongTx, error) {
+	args := m.Called(ctx)
+	return &MockSongTx{MockSongDB: m}, args.Error(0)
+}
+
+// MockSongTx runs the operations of the transaction on the MockSongDB it is
+// begun from, so the expectations of a test hold inside the transactions.
+type MockSongTx struct {
+	*MockSongDB
+}
+
+func (m *MockSongTx) Commit() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockSongTx) Rollback() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// allowTx lets the transactions begin, commit and roll back. A test injects
+// a failure by registering a failing call before allowTx is called.
+func allowTx(mockRepo *MockSongDB) {
+	mockRepo.On("BeginTx", mock.Anything).Return(nil).Maybe()
+	mockRepo.On("Commit").Return(nil).Maybe()
+	mockRepo.On("Rollback").Return(nil).Maybe()
+}
+
+// newController builds a controller over an empty database.
+func newController(t *testing.T, mockRepo *MockSongDB) IPlaylistController {
+	allowTx(mockRepo)
+	mockRepo.On("List", mock.Anything).Return([]*data.Song{}, nil).Once()
+	mockRepo.On("GetPlayerState", mock.Anything).Return((*data.PlayerState)(nil), nil).Once()
+	mockRepo.On("ListPlaylists", mock.Anything).Return([]*data.Playlist{}, nil).Once()
+	mockRepo.On("SavePlayerState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	controller, err := NewPlaylistController(context.Background(), mockRepo)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	return controller
+}
+
 type MockPlaybackMusicPlayer struct {
 	mock.Mock
 }
@@ -53,6 +189,11 @@ func (m *MockPlaybackMusicPlayer) Pause() error {
 	return args.Error(0)
 }
 
+func (m *MockPlaybackMusicPlayer) Stop() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func (m *MockPlaybackMusicPlayer) Next() error {
 	args := m.Called()
 	return args.Error(0)
@@ -68,9 +209,38 @@ func (m *MockPlaybackMusicPlayer) AddSong(title string, duration time.Duration)
 	return args.Error(0)
 }
 
+func (m *MockPlaybackMusicPlayer) Position() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockPlaybackMusicPlayer) Seek(offset time.Duration) error {
+	args := m.Called(offset)
+	return args.Error(0)
+}
+
+func (m *MockPlaybackMusicPlayer) State() playlist.PlaybackState {
+	args := m.Called()
+	return args.Get(0).(playlist.PlaybackState)
+}
+
+func (m *MockPlaybackMusicPlayer) SetShuffle(enabled bool, seed int64) {
+	m.Called(enabled, seed)
+}
+
+func (m *MockPlaybackMusicPlayer) SetRepeatMode(mode playlist.RepeatMode) error {
+	args := m.Called(mode)
+	return args.Error(0)
+}
+
+func (m *MockPlaybackMusicPlayer) Subscribe(bufferSize int, policy playlist.OverflowPolicy) *playlist.Subscription {
+	args := m.Called(bufferSize, policy)
+	return args.Get(0).(*playlist.Subscription)
+}
+
 func TestCreateSong(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 	song := &data.Song{
@@ -79,17 +249,30 @@ func TestCreateSong(t *testing.T) {
 	}
 
 	mockRepo.On("Create", ctx, song).Return(1, nil)
-	mockRepo.On("Get", ctx, "Test Song").Return((*data.Song)(nil), nil)
+	mockRepo.On("Find", ctx, "", "Test Song", "").Return((*data.Song)(nil), nil)
 
-	id, err := controller.CreateSong(ctx, song.Title, song.Duration)
+	id, err := controller.CreateSong(ctx, song)
 	assert.NoError(t, err, "expected no error, but got: %v", err)
 	assert.Equal(t, 1, id, "expected song ID to be 1, but got: %d", id)
 
 	mockRepo.AssertCalled(t, "Create", ctx, song)
+
+	// the same title is allowed on another album, the same artist, title
+	// and album is not
+	other := &data.Song{Title: "Test Song", Duration: time.Minute, Artist: "Artist", Album: "Live"}
+	mockRepo.On("Find", ctx, "Artist", "Test Song", "Live").Return((*data.Song)(nil), nil).Once()
+	mockRepo.On("Create", ctx, other).Return(2, nil)
+	id, err = controller.CreateSong(ctx, other)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, 2, id, "expected song ID to be 2, but got: %d", id)
+
+	mockRepo.On("Find", ctx, "Artist", "Test Song", "Live").Return(&data.Song{ID: 2}, nil)
+	_, err = controller.CreateSong(ctx, &data.Song{Title: "Test Song", Duration: time.Minute, Artist: "Artist", Album: "Live"})
+	assert.ErrorIs(t, err, ErrorSongExised, "expected ErrorSongExised, but got: %v", err)
 }
 func TestGetSong(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 
@@ -109,7 +292,7 @@ func TestGetSong(t *testing.T) {
 }
 func TestUpdateSong(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 
@@ -121,19 +304,19 @@ func TestUpdateSong(t *testing.T) {
 	updatedSong.Title = "New Title"
 
 	mockRepo.On("Create", ctx, song).Return(1, nil)
-	mockRepo.On("Update", ctx, song.Title, updatedSong.Title, updatedSong.Duration).Return(nil)
-	mockRepo.On("Get", ctx, "Old Title").Return((*data.Song)(nil), nil)
-	controller.CreateSong(ctx, song.Title, song.Duration)
+	mockRepo.On("Update", ctx, song.Title, &updatedSong).Return(nil)
+	mockRepo.On("Find", ctx, "", "Old Title", "").Return((*data.Song)(nil), nil)
+	controller.CreateSong(ctx, song)
 	mockRepo.On("Get", ctx, "Old Title").Return(song, nil)
-	mockRepo.On("Get", ctx, "New Title").Return((*data.Song)(nil), nil)
+	mockRepo.On("Find", ctx, "", "New Title", "").Return((*data.Song)(nil), nil)
 
-	err := controller.UpdateSong(ctx, "Old Title", "New Title", 2*time.Minute)
+	_, err := controller.UpdateSong(ctx, "Old Title", &updatedSong)
 	assert.Error(t, err, "expected no error, but got: %v", err)
 }
 
 func TestDeleteSong(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 
@@ -143,41 +326,103 @@ func TestDeleteSong(t *testing.T) {
 	}
 	mockRepo.On("Delete", ctx, "Test Song").Return(nil)
 	mockRepo.On("Create", ctx, song).Return(1, nil)
-	mockRepo.On("Get", ctx, "Test Song").Return((*data.Song)(nil), nil)
+	mockRepo.On("Find", ctx, "", "Test Song", "").Return((*data.Song)(nil), nil)
 
-	controller.CreateSong(ctx, song.Title, song.Duration)
+	controller.CreateSong(ctx, song)
 
 	mockRepo.On("Get", ctx, "Test Song").Return(&data.Song{Title: "Test Song", Duration: 3 * time.Minute}, nil)
 
 	err := controller.DeleteSong(ctx, "Test Song")
-	assert.Error(t, err, "expected no error, but got: %v", err)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
 
 	mockRepo.AssertCalled(t, "Get", ctx, "Test Song")
+	mockRepo.AssertCalled(t, "Delete", ctx, "Test Song")
 }
 
 func TestListSongs(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 
 	expectedSongs := []*data.Song{
 		{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
 		{ID: 2, Title: "Song 2", Duration: 3 * time.Minute},
+		{ID: 3, Title: "Song 3", Duration: 3 * time.Minute},
 	}
 
-	mockRepo.On("List", ctx).Return(expectedSongs, nil)
+	// one song more than the page size means there is a next page
+	query := SongQuery{PageSize: 2, OrderBy: data.SongOrderDuration, TitlePrefix: "Song"}
+	mockRepo.On("ListPage", ctx, data.SongListOptions{Limit: 3, OrderBy: data.SongOrderDuration, TitlePrefix: "Song"}).
+		Return(expectedSongs, nil)
 
-	songs, err := controller.ListSongs(ctx)
+	page, err := controller.ListSongs(ctx, query)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, expectedSongs[:2], page.Songs, "expected songs list to match")
+	assert.NotEmpty(t, page.NextPageToken, "expected a token of the next page")
+	raw, err := base64.RawURLEncoding.DecodeString(page.NextPageToken)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.NotContains(t, string(raw), `"c"`, "expected the token without the creation time")
+
+	// the next page starts after the last song of the previous one
+	query.PageToken = page.NextPageToken
+	mockRepo.On("ListPage", ctx, data.SongListOptions{
+		Limit:       3,
+		OrderBy:     data.SongOrderDuration,
+		After:       &data.Song{ID: 2, Duration: 3 * time.Minute},
+		TitlePrefix: "Song",
+	}).Return(expectedSongs[2:], nil)
+
+	page, err = controller.ListSongs(ctx, query)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, expectedSongs[2:], page.Songs, "expected songs list to match")
+	assert.Empty(t, page.NextPageToken, "expected no token on the last page")
+
+	// the token of one order cannot be used with another one
+	_, err = controller.ListSongs(ctx, SongQuery{PageToken: query.PageToken, OrderBy: data.SongOrderTitle})
+	assert.ErrorIs(t, err, ErrorInvalidPageToken, "expected ErrorInvalidPageToken, but got: %v", err)
+	_, err = controller.ListSongs(ctx, SongQuery{PageToken: "not a token"})
+	assert.ErrorIs(t, err, ErrorInvalidPageToken, "expected ErrorInvalidPageToken, but got: %v", err)
+	_, err = controller.ListSongs(ctx, SongQuery{PageSize: -1})
+	assert.ErrorIs(t, err, ErrorNotValidPageSize, "expected ErrorNotValidPageSize, but got: %v", err)
+
+	// the default size is used for zero and large sizes are cut
+	mockRepo.On("ListPage", ctx, data.SongListOptions{Limit: DefaultPageSize + 1}).Return(expectedSongs, nil)
+	mockRepo.On("ListPage", ctx, data.SongListOptions{Limit: MaxPageSize + 1}).Return(expectedSongs, nil)
+	_, err = controller.ListSongs(ctx, SongQuery{})
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	_, err = controller.ListSongs(ctx, SongQuery{PageSize: MaxPageSize * 10})
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+}
+
+func TestSearchSongs(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	expectedSongs := []*data.Song{
+		{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
+	}
+	mockRepo.On("Search", ctx, "son", DefaultPageSize).Return(expectedSongs, nil)
+	mockRepo.On("Search", ctx, "son", MaxPageSize).Return(expectedSongs, nil)
+
+	songs, err := controller.SearchSongs(ctx, "son", 0)
 	assert.NoError(t, err, "expected no error, but got: %v", err)
 	assert.Equal(t, expectedSongs, songs, "expected songs list to match")
 
-	mockRepo.AssertCalled(t, "List", ctx)
+	_, err = controller.SearchSongs(ctx, "son", MaxPageSize+1)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	_, err = controller.SearchSongs(ctx, "  ", 0)
+	assert.ErrorIs(t, err, ErrorEmptySearchQuery, "expected ErrorEmptySearchQuery, but got: %v", err)
+	_, err = controller.SearchSongs(ctx, "son", -1)
+	assert.ErrorIs(t, err, ErrorNotValidPageSize, "expected ErrorNotValidPageSize, but got: %v", err)
 }
 
 func TestPlayPause(t *testing.T) {
 	mockRepo := new(MockSongDB)
-	controller := NewPlaylistController(mockRepo)
+	controller := newController(t, mockRepo)
 
 	ctx := context.Background()
 
@@ -186,12 +431,421 @@ func TestPlayPause(t *testing.T) {
 		Duration: 3 * time.Minute,
 	}
 	mockRepo.On("Create", ctx, song).Return(1, nil)
-	mockRepo.On("Get", ctx, "Test Song").Return((*data.Song)(nil), nil)
-	controller.CreateSong(ctx, song.Title, song.Duration)
+	mockRepo.On("Find", ctx, "", "Test Song", "").Return((*data.Song)(nil), nil)
+	controller.CreateSong(ctx, song)
 
-	err := controller.PlaySong(context.Background())
+	err := controller.PlaySong(context.Background(), DefaultPlaylistID)
 	assert.NoError(t, err, "expected no error on Play, but got: %v", err)
 
-	err = controller.PauseSong(context.Background())
+	err = controller.PauseSong(context.Background(), DefaultPlaylistID)
 	assert.NoError(t, err, "expected no error on Pause, but got: %v", err)
 }
+
+func TestPlayAt(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	mockRepo.On("Find", ctx, "", mock.Anything, "").Return((*data.Song)(nil), nil)
+	mockRepo.On("Create", ctx, mock.Anything).Return(1, nil)
+	controller.CreateSong(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+	controller.CreateSong(ctx, &data.Song{Title: "Song 2", Duration: time.Minute})
+
+	err := controller.PlaySongByTitle(ctx, DefaultPlaylistID, "Song 2")
+	assert.NoError(t, err, "expected no error on PlaySongByTitle, but got: %v", err)
+
+	state, _ := controller.GetPlaybackState(ctx, DefaultPlaylistID)
+	assert.Equal(t, "Song 2", state.Song.Title, "expected 'Song 2' to be playing")
+
+	err = controller.PlayAt(ctx, DefaultPlaylistID, 0)
+	assert.NoError(t, err, "expected no error on PlayAt, but got: %v", err)
+
+	state, _ = controller.GetPlaybackState(ctx, DefaultPlaylistID)
+	assert.Equal(t, "Song 1", state.Song.Title, "expected 'Song 1' to be playing")
+
+	err = controller.PlaySongByTitle(ctx, DefaultPlaylistID, "Song 3")
+	assert.Equal(t, playlist.ErrorNotFoundSong, err, "expected error %v, but got: %v", playlist.ErrorNotFoundSong, err)
+
+	err = controller.PlayAt(ctx, 5, 0)
+	assert.Equal(t, ErrorNotFoundPlaylist, err, "expected error %v, but got: %v", ErrorNotFoundPlaylist, err)
+}
+
+func TestStopSong(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	song := &data.Song{
+		Title:    "Test Song",
+		Duration: 3 * time.Minute,
+	}
+	mockRepo.On("Create", ctx, song).Return(1, nil)
+	mockRepo.On("Find", ctx, "", "Test Song", "").Return((*data.Song)(nil), nil).Once()
+	mockRepo.On("Delete", ctx, "Test Song").Return(nil)
+	controller.CreateSong(ctx, song)
+
+	err := controller.StopSong(ctx, DefaultPlaylistID)
+	assert.Equal(t, playlist.ErrorNotPlayingPlaylist, err, "expected error %v, but got: %v", playlist.ErrorNotPlayingPlaylist, err)
+
+	err = controller.PlaySong(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected no error on Play, but got: %v", err)
+
+	err = controller.StopSong(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected no error on Stop, but got: %v", err)
+
+	// the stopped song can be deleted
+	mockRepo.On("Get", ctx, "Test Song").Return(song, nil)
+	err = controller.DeleteSong(ctx, "Test Song")
+	assert.NoError(t, err, "expected no error on Delete, but got: %v", err)
+}
+
+func TestNewPlaylistController(t *testing.T) {
+	mockRepo := new(MockSongDB)
+
+	ctx := context.Background()
+
+	songs := []*data.Song{
+		{ID: 1, Title: "Song 1", Duration: 2 * time.Minute},
+		{ID: 2, Title: "Song 2", Duration: 3 * time.Minute},
+	}
+	state := &data.PlayerState{SongID: 2, Position: time.Minute, RepeatMode: int(playlist.RepeatOne)}
+	mockRepo.On("List", ctx).Return(songs, nil)
+	mockRepo.On("GetPlayerState", ctx).Return(state, nil)
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil)
+	mockRepo.On("Get", ctx, "Song 2").Return(songs[1], nil)
+	mockRepo.On("SavePlayerState", ctx, mock.Anything).Return(nil)
+
+	controller, err := NewPlaylistController(ctx, mockRepo)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	playback, err := controller.GetPlaybackState(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, "Song 2", playback.Song.Title, "expected 'Song 2' to be restored")
+	assert.Equal(t, 1, playback.Index, "expected index to be 1")
+	assert.Equal(t, time.Minute, playback.Elapsed, "expected position to be restored")
+	assert.Equal(t, playlist.StatusStopped, playback.Status, "expected player to be stopped")
+	assert.Equal(t, playlist.RepeatOne, playback.Repeat, "expected repeat mode to be restored")
+
+	err = controller.PlaySong(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected the loaded songs to be playable, but got: %v", err)
+}
+
+func TestNewPlaylistControllerError(t *testing.T) {
+	mockRepo := new(MockSongDB)
+
+	ctx := context.Background()
+
+	mockRepo.On("List", ctx).Return([]*data.Song(nil), errors.New("connection refused"))
+
+	_, err := NewPlaylistController(ctx, mockRepo)
+	assert.Error(t, err, "expected error when the songs cannot be loaded")
+}
+
+func TestSaveState(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	song := &data.Song{ID: 7, Title: "Test Song", Duration: 3 * time.Minute}
+	mockRepo.On("Create", ctx, mock.Anything).Return(7, nil)
+	mockRepo.On("Find", ctx, "", "Test Song", "").Return((*data.Song)(nil), nil).Once()
+	controller.CreateSong(ctx, &data.Song{Title: song.Title, Duration: song.Duration})
+
+	mockRepo.On("Get", ctx, "Test Song").Return(song, nil)
+
+	err := controller.SeekSong(ctx, DefaultPlaylistID, time.Minute)
+	assert.NoError(t, err, "expected no error on Seek, but got: %v", err)
+
+	expected := &data.PlayerState{SongID: 7, Position: time.Minute, RepeatMode: int(playlist.RepeatAll)}
+	mockRepo.AssertCalled(t, "SavePlayerState", ctx, expected)
+}
+
+func TestCreatePlaylist(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	_, err := controller.CreatePlaylist(ctx, "")
+	assert.Equal(t, ErrorEmptyPlaylistName, err, "expected error %v, but got: %v", ErrorEmptyPlaylistName, err)
+
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{{ID: 1, Name: "Favourites"}}, nil)
+
+	_, err = controller.CreatePlaylist(ctx, "Favourites")
+	assert.Equal(t, ErrorPlaylistExists, err, "expected error %v, but got: %v", ErrorPlaylistExists, err)
+
+	mockRepo.On("CreatePlaylist", ctx, "Road trip").Return(2, nil)
+
+	id, err := controller.CreatePlaylist(ctx, "Road trip")
+	assert.NoError(t, err, "expected no error on CreatePlaylist, but got: %v", err)
+	assert.Equal(t, 2, id, "expected playlist ID to match")
+
+	// the new playlist has its own empty player
+	err = controller.PlaySong(ctx, id)
+	assert.Equal(t, playlist.ErrorEmptyPlaylist, err, "expected error %v, but got: %v", playlist.ErrorEmptyPlaylist, err)
+}
+
+func TestPlaylistSongs(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	song := &data.Song{ID: 3, Title: "Test Song", Duration: 3 * time.Minute}
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil).Once()
+	mockRepo.On("CreatePlaylist", ctx, "Favourites").Return(1, nil)
+	mockRepo.On("GetPlaylist", ctx, 1).Return(&data.Playlist{ID: 1, Name: "Favourites"}, nil)
+	mockRepo.On("GetPlaylist", ctx, 5).Return((*data.Playlist)(nil), nil)
+	mockRepo.On("Get", ctx, "Test Song").Return(song, nil)
+	mockRepo.On("AddPlaylistSong", ctx, 1, 3).Return(nil)
+	mockRepo.On("RemovePlaylistSong", ctx, 1, 3).Return(nil)
+
+	id, err := controller.CreatePlaylist(ctx, "Favourites")
+	assert.NoError(t, err, "expected no error on CreatePlaylist, but got: %v", err)
+
+	err = controller.AddSongToPlaylist(ctx, 5, "Test Song")
+	assert.Equal(t, ErrorNotFoundPlaylist, err, "expected error %v, but got: %v", ErrorNotFoundPlaylist, err)
+
+	err = controller.AddSongToPlaylist(ctx, id, "Test Song")
+	assert.NoError(t, err, "expected no error on AddSongToPlaylist, but got: %v", err)
+
+	// the same song can be added twice
+	err = controller.AddSongToPlaylist(ctx, id, "Test Song")
+	assert.NoError(t, err, "expected no error on AddSongToPlaylist, but got: %v", err)
+	mockRepo.AssertNumberOfCalls(t, "AddPlaylistSong", 2)
+
+	err = controller.PlaySong(ctx, id)
+	assert.NoError(t, err, "expected no error on Play, but got: %v", err)
+
+	state, err := controller.GetPlaybackState(ctx, id)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, "Test Song", state.Song.Title, "expected the playlist to play 'Test Song'")
+
+	err = controller.StopSong(ctx, id)
+	assert.NoError(t, err, "expected no error on Stop, but got: %v", err)
+
+	err = controller.RemoveSongFromPlaylist(ctx, id, "Test Song")
+	assert.NoError(t, err, "expected no error on RemoveSongFromPlaylist, but got: %v", err)
+	mockRepo.AssertCalled(t, "RemovePlaylistSong", ctx, 1, 3)
+
+	state, err = controller.GetPlaybackState(ctx, id)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, "Test Song", state.Song.Title, "expected the second entry of 'Test Song' to stay")
+}
+
+func TestDeleteSongFromPlaylists(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	song := &data.Song{ID: 3, Title: "Test Song", Duration: 3 * time.Minute}
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil).Once()
+	mockRepo.On("CreatePlaylist", ctx, "Favourites").Return(1, nil)
+	mockRepo.On("GetPlaylist", ctx, 1).Return(&data.Playlist{ID: 1, Name: "Favourites"}, nil)
+	mockRepo.On("Get", ctx, "Test Song").Return(song, nil)
+	mockRepo.On("AddPlaylistSong", ctx, 1, 3).Return(nil)
+	mockRepo.On("Delete", ctx, "Test Song").Return(nil)
+
+	id, err := controller.CreatePlaylist(ctx, "Favourites")
+	assert.NoError(t, err, "expected no error on CreatePlaylist, but got: %v", err)
+
+	controller.AddSongToPlaylist(ctx, id, "Test Song")
+	controller.AddSongToPlaylist(ctx, id, "Test Song")
+
+	err = controller.PlaySong(ctx, id)
+	assert.NoError(t, err, "expected no error on Play, but got: %v", err)
+
+	// a song playing in a playlist cannot be deleted from the library
+	err = controller.DeleteSong(ctx, "Test Song")
+	assert.ErrorIs(t, err, ErrorPlayingInPlaylist, "expected error %v, but got: %v", ErrorPlayingInPlaylist, err)
+	mockRepo.AssertNotCalled(t, "Delete", ctx, "Test Song")
+
+	err = controller.StopSong(ctx, id)
+	assert.NoError(t, err, "expected no error on Stop, but got: %v", err)
+
+	// all entries of the song are removed from the playlist
+	err = controller.DeleteSong(ctx, "Test Song")
+	assert.NoError(t, err, "expected no error on Delete, but got: %v", err)
+
+	err = controller.PlaySong(ctx, id)
+	assert.Equal(t, playlist.ErrorEmptyPlaylist, err, "expected error %v, but got: %v", playlist.ErrorEmptyPlaylist, err)
+}
+
+func TestReorderSongs(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	library := []*data.Song{
+		{ID: 1, Title: "Song 1", Duration: time.Minute},
+		{ID: 2, Title: "Song 2", Duration: time.Minute},
+		{ID: 3, Title: "Song 3", Duration: time.Minute},
+	}
+	mockRepo.On("Find", ctx, "", mock.Anything, "").Return((*data.Song)(nil), nil).Times(3)
+	for _, song := range library {
+		mockRepo.On("Create", ctx, &data.Song{Title: song.Title, Duration: song.Duration}).Return(song.ID, nil).Once()
+		controller.CreateSong(ctx, &data.Song{Title: song.Title, Duration: song.Duration})
+	}
+
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil).Once()
+	mockRepo.On("CreatePlaylist", ctx, "Favourites").Return(1, nil)
+	mockRepo.On("GetPlaylist", ctx, 1).Return(&data.Playlist{ID: 1, Name: "Favourites"}, nil)
+	for _, song := range library {
+		mockRepo.On("Get", ctx, song.Title).Return(song, nil)
+		mockRepo.On("AddPlaylistSong", ctx, 1, song.ID).Return(nil)
+	}
+	mockRepo.On("SetPlaylistSongs", ctx, 1, []int{3, 1, 2}).Return(nil).Once()
+	mockRepo.On("SetPlaylistSongs", ctx, 1, []int{2, 1, 3}).Return(nil).Once()
+
+	id, err := controller.CreatePlaylist(ctx, "Favourites")
+	assert.NoError(t, err, "expected no error on CreatePlaylist, but got: %v", err)
+	for _, song := range library {
+		controller.AddSongToPlaylist(ctx, id, song.Title)
+	}
+
+	err = controller.MoveSong(ctx, id, "Song 3", 0)
+	assert.NoError(t, err, "expected no error on MoveSong, but got: %v", err)
+
+	err = controller.SwapSongs(ctx, id, "Song 3", "Song 2")
+	assert.NoError(t, err, "expected no error on SwapSongs, but got: %v", err)
+
+	err = controller.MoveSong(ctx, id, "Song 4", 0)
+	assert.Equal(t, playlist.ErrorNotFoundSong, err, "expected error %v, but got: %v", playlist.ErrorNotFoundSong, err)
+
+	// the library keeps the order of creation
+	err = controller.MoveSong(ctx, DefaultPlaylistID, "Song 3", 0)
+	assert.Equal(t, ErrorLibraryOrder, err, "expected error %v, but got: %v", ErrorLibraryOrder, err)
+	err = controller.SwapSongs(ctx, DefaultPlaylistID, "Song 3", "Song 2")
+	assert.Equal(t, ErrorLibraryOrder, err, "expected error %v, but got: %v", ErrorLibraryOrder, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestInsertSong(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	library := []*data.Song{
+		{ID: 1, Title: "Song 1", Duration: time.Minute},
+		{ID: 2, Title: "Song 2", Duration: time.Minute},
+	}
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil).Once()
+	mockRepo.On("CreatePlaylist", ctx, "Favourites").Return(1, nil)
+	mockRepo.On("GetPlaylist", ctx, 1).Return(&data.Playlist{ID: 1, Name: "Favourites"}, nil)
+	mockRepo.On("Get", ctx, "Song 1").Return(library[0], nil)
+	mockRepo.On("Get", ctx, "Song 2").Return(library[1], nil)
+	mockRepo.On("AddPlaylistSong", ctx, 1, 1).Return(nil)
+	mockRepo.On("List", ctx).Return(library, nil)
+	mockRepo.On("SetPlaylistSongs", ctx, 1, []int{2, 1, 1}).Return(nil)
+
+	id, err := controller.CreatePlaylist(ctx, "Favourites")
+	assert.NoError(t, err, "expected no error on CreatePlaylist, but got: %v", err)
+
+	controller.AddSongToPlaylist(ctx, id, "Song 1")
+	controller.AddSongToPlaylist(ctx, id, "Song 1")
+
+	err = controller.InsertSong(ctx, id, "Song 2", 0)
+	assert.NoError(t, err, "expected no error on InsertSong, but got: %v", err)
+	mockRepo.AssertCalled(t, "SetPlaylistSongs", ctx, 1, []int{2, 1, 1})
+
+	err = controller.InsertSong(ctx, id, "Song 2", 5)
+	assert.Equal(t, playlist.ErrorNotValidIndex, err, "expected error %v, but got: %v", playlist.ErrorNotValidIndex, err)
+
+	err = controller.InsertSong(ctx, DefaultPlaylistID, "Song 2", 0)
+	assert.Equal(t, ErrorLibraryOrder, err, "expected error %v, but got: %v", ErrorLibraryOrder, err)
+}
+
+func TestQueue(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	mockRepo.On("Find", ctx, "", mock.Anything, "").Return((*data.Song)(nil), nil)
+	mockRepo.On("Create", ctx, mock.Anything).Return(1, nil).Once()
+	mockRepo.On("Create", ctx, mock.Anything).Return(2, nil).Once()
+	controller.CreateSong(ctx, &data.Song{Title: "Song 1", Duration: time.Minute})
+	controller.CreateSong(ctx, &data.Song{Title: "Song 2", Duration: time.Minute})
+
+	err := controller.EnqueueNext(ctx, DefaultPlaylistID, "Song 2")
+	assert.NoError(t, err, "expected no error on EnqueueNext, but got: %v", err)
+
+	err = controller.EnqueueNext(ctx, DefaultPlaylistID, "Song 3")
+	assert.Equal(t, playlist.ErrorNotFoundSong, err, "expected error %v, but got: %v", playlist.ErrorNotFoundSong, err)
+
+	queue, err := controller.ListQueue(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected no error on ListQueue, but got: %v", err)
+	assert.Equal(t, []playlist.Song{{ID: 2, Title: "Song 2", Duration: time.Minute}}, queue, "expected only 'Song 2' in the queue")
+
+	err = controller.ClearQueue(ctx, DefaultPlaylistID)
+	assert.NoError(t, err, "expected no error on ClearQueue, but got: %v", err)
+
+	queue, _ = controller.ListQueue(ctx, DefaultPlaylistID)
+	assert.Empty(t, queue, "expected the queue to be empty")
+
+	err = controller.EnqueueNext(ctx, 5, "Song 1")
+	assert.Equal(t, ErrorNotFoundPlaylist, err, "expected error %v, but got: %v", ErrorNotFoundPlaylist, err)
+}
+
+func TestSongByID(t *testing.T) {
+	mockRepo := new(MockSongDB)
+	controller := newController(t, mockRepo)
+
+	ctx := context.Background()
+
+	song1 := &data.Song{ID: 1, Title: "Song 1", Duration: time.Minute}
+	song2 := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+	mockRepo.On("Find", ctx, "", "Song 1", "").Return((*data.Song)(nil), nil).Once()
+	mockRepo.On("Find", ctx, "", "Song 2", "").Return((*data.Song)(nil), nil).Once()
+	mockRepo.On("Create", ctx, mock.Anything).Return(1, nil).Once()
+	mockRepo.On("Create", ctx, mock.Anything).Return(2, nil).Once()
+	controller.CreateSong(ctx, &data.Song{Title: song1.Title, Duration: song1.Duration})
+	controller.CreateSong(ctx, &data.Song{Title: song2.Title, Duration: song2.Duration})
+
+	mockRepo.On("Find", ctx, "", "Song 1", "").Return(song1, nil)
+	mockRepo.On("Find", ctx, "", "Song 2", "").Return(song2, nil)
+	mockRepo.On("GetByID", ctx, 2).Return(song2, nil)
+	mockRepo.On("GetByID", ctx, 5).Return((*data.Song)(nil), nil)
+	updated := &data.Song{Title: "Song 2", Duration: 2 * time.Minute, Artist: "Artist", Year: 1975}
+	mockRepo.On("Find", ctx, "Artist", "Song 2", "").Return(song2, nil)
+	mockRepo.On("UpdateByID", ctx, 2, updated).Return(nil)
+	mockRepo.On("DeleteByID", ctx, 2).Return(nil)
+
+	song, err := controller.GetSongByID(ctx, 2)
+	assert.NoError(t, err, "expected no error on GetSongByID, but got: %v", err)
+	assert.Equal(t, song2, song, "expected song to match")
+
+	_, err = controller.GetSongByID(ctx, 5)
+	assert.Equal(t, playlist.ErrorNotFoundSong, err, "expected error %v, but got: %v", playlist.ErrorNotFoundSong, err)
+
+	err = controller.UpdateSongByID(ctx, 2, &data.Song{Title: "Song 1", Duration: time.Minute})
+	assert.Equal(t, ErrorSongExised, err, "expected error %v, but got: %v", ErrorSongExised, err)
+
+	// the song keeps its own title
+	err = controller.UpdateSongByID(ctx, 2, updated)
+	assert.NoError(t, err, "expected no error on UpdateSongByID, but got: %v", err)
+
+	controller.PlayAt(ctx, DefaultPlaylistID, 1)
+	state, _ := controller.GetPlaybackState(ctx, DefaultPlaylistID)
+	assert.Equal(t, playlist.Song{ID: 2, Title: "Song 2", Duration: 2 * time.Minute, Artist: "Artist", Year: 1975}, *state.Song, "expected the updated song with its metadata")
+
+	err = controller.DeleteSongByID(ctx, 2)
+	assert.Equal(t, playlist.ErrorPlayingSong, err, "expected error %v, but got: %v", playlist.ErrorPlayingSong, err)
+
+	controller.StopSong(ctx, DefaultPlaylistID)
+	err = controller.DeleteSongByID(ctx, 2)
+	assert.NoError(t, err, "expected no error on DeleteSongByID, but got: %v", err)
+
+	err = controller.PlaySongByTitle(ctx, DefaultPlaylistID, "Song 2")
+	assert.Equal(t, playlist.ErrorNotFoundSong, err, "expected error %v, but got: %v", playlist.ErrorNotFoundSong, err)
+
+	err = controller.DeleteSongByID(ctx, 5)
+	assert.Equal(t, ErrorNotFoundSongOnBase, err, "expected error %v, but got: %v", ErrorNotFoundSongOnBase, err)
+}
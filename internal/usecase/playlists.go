@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"MusicPlayerProject/internal/data"
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
+	"context"
+)
+
+func (c *playlistController) CreatePlaylist(ctx context.Context, name string) (int, error) {
+	if name == "" {
+		return 0, ErrorEmptyPlaylistName
+	}
+
+	var id int
+	err := c.inTx(ctx, func(u *unitOfWork) error {
+		err := checkPlaylistName(ctx, u.tx, name)
+		if err != nil {
+			return err
+		}
+
+		id, err = u.tx.CreatePlaylist(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		u.afterCommit(func() error {
+			c.playersMutex.Lock()
+			defer c.playersMutex.Unlock()
+
+			c.players[id] = playlist.NewPlaylist()
+			return nil
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (c *playlistController) ListPlaylists(ctx context.Context) ([]*data.Playlist, error) {
+	playlists, err := c.db.ListPlaylists(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range playlists {
+		p.Songs, err = c.db.ListPlaylistSongs(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return playlists, nil
+}
+
+func (c *playlistController) RenamePlaylist(ctx context.Context, id int, name string) error {
+	if name == "" {
+		return ErrorEmptyPlaylistName
+	}
+
+	p, err := c.getPlaylist(ctx, id)
+	if err != nil {
+		return err
+	}
+	if p.Name == name {
+		return nil
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		err := checkPlaylistName(ctx, u.tx, name)
+		if err != nil {
+			return err
+		}
+
+		return u.tx.RenamePlaylist(ctx, id, name)
+	})
+}
+
+// DeletePlaylist stops the playback of the playlist and deletes it. The
+// songs stay in the library.
+func (c *playlistController) DeletePlaylist(ctx context.Context, id int) error {
+	_, err := c.getPlaylist(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	player, err := c.player(id)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		err := u.tx.DeletePlaylist(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// the playback goes on if the playlist is not deleted after all
+		u.afterCommit(func() error {
+			// Stop fails only when the player is not playing anyway
+			player.Stop()
+
+			c.playersMutex.Lock()
+			defer c.playersMutex.Unlock()
+
+			delete(c.players, id)
+			return nil
+		})
+		return nil
+	})
+}
+
+// AddSongToPlaylist appends the library song to the end of the playlist. The
+// same song may be added more than once.
+func (c *playlistController) AddSongToPlaylist(ctx context.Context, playlistID int, title string) error {
+	_, err := c.getPlaylist(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		song, err := getSong(ctx, u.tx, title)
+		if err != nil {
+			return err
+		}
+
+		err = u.tx.AddPlaylistSong(ctx, playlistID, song.ID)
+		if err != nil {
+			return err
+		}
+
+		u.addEntry(player, libraryEntry(song))
+		return nil
+	})
+}
+
+// RemoveSongFromPlaylist removes the first entry of the song from the
+// playlist. The song stays in the library.
+func (c *playlistController) RemoveSongFromPlaylist(ctx context.Context, playlistID int, title string) error {
+	_, err := c.getPlaylist(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		song, err := getSong(ctx, u.tx, title)
+		if err != nil {
+			return err
+		}
+
+		err = u.tx.RemovePlaylistSong(ctx, playlistID, song.ID)
+		if err != nil {
+			return err
+		}
+
+		return u.removeEntry(player, song.ID)
+	})
+}
+
+// InsertSong inserts the library song into the named playlist at the index.
+// The playlist of the whole library holds every song once, in the order of
+// CreateSong, so it is neither inserted into nor reordered.
+func (c *playlistController) InsertSong(ctx context.Context, playlistID int, title string, index int) error {
+	if playlistID == DefaultPlaylistID {
+		return ErrorLibraryOrder
+	}
+
+	_, err := c.getPlaylist(ctx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		song, err := getSong(ctx, u.tx, title)
+		if err != nil {
+			return err
+		}
+
+		songs, err := u.insertEntry(player, libraryEntry(song), index)
+		if err != nil {
+			return err
+		}
+
+		return saveOrder(ctx, u.tx, playlistID, songs)
+	})
+}
+
+func (c *playlistController) MoveSong(ctx context.Context, playlistID int, title string, index int) error {
+	if playlistID == DefaultPlaylistID {
+		return ErrorLibraryOrder
+	}
+
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		from := indexOf(player.Songs(), title)
+		if from < 0 {
+			return playlist.ErrorNotFoundSong
+		}
+
+		songs, err := u.moveEntry(player, from, index)
+		if err != nil {
+			return err
+		}
+
+		return saveOrder(ctx, u.tx, playlistID, songs)
+	})
+}
+
+func (c *playlistController) SwapSongs(ctx context.Context, playlistID int, first string, second string) error {
+	if playlistID == DefaultPlaylistID {
+		return ErrorLibraryOrder
+	}
+
+	player, err := c.player(playlistID)
+	if err != nil {
+		return err
+	}
+
+	return c.inTx(ctx, func(u *unitOfWork) error {
+		songs, err := u.swapEntries(player, first, second)
+		if err != nil {
+			return err
+		}
+
+		return saveOrder(ctx, u.tx, playlistID, songs)
+	})
+}
+
+// saveOrder stores the songs as the entries of the named playlist.
+func saveOrder(ctx context.Context, db db_song.SongDB, playlistID int, songs []playlist.Song) error {
+	order := make([]int, 0, len(songs))
+	for _, song := range songs {
+		order = append(order, song.ID)
+	}
+	return db.SetPlaylistSongs(ctx, playlistID, order)
+}
+
+// getSong returns the first song of the library with the title or
+// ErrorNotFoundSongOnBase.
+func getSong(ctx context.Context, db db_song.SongDB, title string) (*data.Song, error) {
+	song, err := db.Get(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	if song == nil {
+		return nil, ErrorNotFoundSongOnBase
+	}
+	return song, nil
+}
+
+// indexOf returns the index of the first song with the title or -1.
+func indexOf(songs []playlist.Song, title string) int {
+	for i, song := range songs {
+		if song.Title == title {
+			return i
+		}
+	}
+	return -1
+}
+
+// getPlaylist returns the named playlist or ErrorNotFoundPlaylist.
+func (c *playlistController) getPlaylist(ctx context.Context, id int) (*data.Playlist, error) {
+	if id == DefaultPlaylistID {
+		return nil, ErrorNotFoundPlaylist
+	}
+
+	p, err := c.db.GetPlaylist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrorNotFoundPlaylist
+	}
+	return p, nil
+}
+
+func checkPlaylistName(ctx context.Context, db db_song.SongDB, name string) error {
+	playlists, err := db.ListPlaylists(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range playlists {
+		if p.Name == name {
+			return ErrorPlaylistExists
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"MusicPlayerProject/internal/data"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the size of a page of the library when the client
+	// does not choose one.
+	DefaultPageSize = 50
+	// MaxPageSize caps the page size, larger pages are cut to it.
+	MaxPageSize = 1000
+)
+
+var (
+	ErrorNotValidPageSize  = errors.New("The page size cannot be negative")
+	ErrorInvalidPageToken  = errors.New("The page token is invalid or belongs to another order")
+	ErrorNotValidSongOrder = errors.New("The order of the songs is unknown")
+	ErrorEmptySearchQuery  = errors.New("The search query cannot be empty")
+)
+
+// SongQuery selects a page of the library. PageToken is the NextPageToken
+// of the previous page or empty for the first page; the order must stay
+// the same between the pages.
+type SongQuery struct {
+	PageSize    int
+	PageToken   string
+	OrderBy     data.SongOrder
+	Descending  bool
+	MinDuration time.Duration
+	MaxDuration time.Duration
+	TitlePrefix string
+}
+
+// SongPage is a page of the library. NextPageToken is empty on the last page.
+type SongPage struct {
+	Songs         []*data.Song
+	NextPageToken string
+}
+
+// pageCursor is what a page token carries: the order of the pages and the
+// sort key of the last song of the previous page. Only the key of the order
+// is set, the others are left out of the token.
+type pageCursor struct {
+	OrderBy    data.SongOrder `json:"o"`
+	Descending bool           `json:"d,omitempty"`
+	ID         int            `json:"i"`
+	Title      string         `json:"t,omitempty"`
+	Duration   int64          `json:"s,omitempty"`
+	CreatedAt  *time.Time     `json:"c,omitempty"`
+}
+
+func encodePageToken(query SongQuery, last *data.Song) string {
+	cursor := pageCursor{OrderBy: query.OrderBy, Descending: query.Descending, ID: last.ID}
+	switch query.OrderBy {
+	case data.SongOrderTitle:
+		cursor.Title = last.Title
+	case data.SongOrderDuration:
+		cursor.Duration = int64(last.Duration.Seconds())
+	case data.SongOrderCreatedAt:
+		cursor.CreatedAt = &last.CreatedAt
+	}
+
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken returns the last song of the previous page as far as the
+// order needs it.
+func decodePageToken(query SongQuery) (*data.Song, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+	if err != nil {
+		return nil, ErrorInvalidPageToken
+	}
+
+	var cursor pageCursor
+	err = json.Unmarshal(raw, &cursor)
+	if err != nil {
+		return nil, ErrorInvalidPageToken
+	}
+	if cursor.OrderBy != query.OrderBy || cursor.Descending != query.Descending {
+		return nil, ErrorInvalidPageToken
+	}
+
+	last := &data.Song{
+		ID:       cursor.ID,
+		Title:    cursor.Title,
+		Duration: time.Duration(cursor.Duration) * time.Second,
+	}
+	if cursor.CreatedAt != nil {
+		last.CreatedAt = *cursor.CreatedAt
+	} else if cursor.OrderBy == data.SongOrderCreatedAt {
+		return nil, ErrorInvalidPageToken
+	}
+	return last, nil
+}
+
+// ListSongs returns a page of the library. One song more than the page size
+// is read to know whether there is a next page.
+func (c *playlistController) ListSongs(ctx context.Context, query SongQuery) (*SongPage, error) {
+	if query.PageSize < 0 {
+		return nil, ErrorNotValidPageSize
+	}
+	if query.OrderBy < data.SongOrderID || query.OrderBy > data.SongOrderCreatedAt {
+		return nil, ErrorNotValidSongOrder
+	}
+
+	pageSize := query.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	opts := data.SongListOptions{
+		Limit:       pageSize + 1,
+		OrderBy:     query.OrderBy,
+		Descending:  query.Descending,
+		MinDuration: query.MinDuration,
+		MaxDuration: query.MaxDuration,
+		TitlePrefix: query.TitlePrefix,
+	}
+	if query.PageToken != "" {
+		after, err := decodePageToken(query)
+		if err != nil {
+			return nil, err
+		}
+		opts.After = after
+	}
+
+	songs, err := c.db.ListPage(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &SongPage{Songs: songs}
+	if len(songs) > pageSize {
+		page.Songs = songs[:pageSize]
+		page.NextPageToken = encodePageToken(query, page.Songs[pageSize-1])
+	}
+	return page, nil
+}
+
+// SearchSongs returns the songs best matching the query by their titles,
+// artists and albums. A zero limit is the default page size.
+func (c *playlistController) SearchSongs(ctx context.Context, query string, limit int) ([]*data.Song, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrorEmptySearchQuery
+	}
+	if limit < 0 {
+		return nil, ErrorNotValidPageSize
+	}
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	return c.db.Search(ctx, query, min(limit, MaxPageSize))
+}
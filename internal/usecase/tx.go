@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	db_song "MusicPlayerProject/internal/db"
+	"MusicPlayerProject/internal/playlist"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+)
+
+// unitOfWork is an operation spanning the database and the playback
+// engines. The database steps and the checks of the in-memory steps run in
+// tx, the in-memory steps themselves are deferred to the commit, so the
+// players and their subscribers see only the committed changes.
+//
+// The deletions are the exception: a player refuses to delete the song it
+// is playing and only the player can check it at the moment of the delete,
+// so they are made before the commit and undone when the transaction rolls
+// back.
+type unitOfWork struct {
+	tx       db_song.SongTx
+	undo     []func() error
+	onCommit []func() error
+}
+
+// compensate registers the undo of an in-memory step which has been made.
+func (u *unitOfWork) compensate(undo func() error) {
+	u.undo = append(u.undo, undo)
+}
+
+// afterCommit defers an in-memory step until the transaction commits.
+func (u *unitOfWork) afterCommit(step func() error) {
+	u.onCommit = append(u.onCommit, step)
+}
+
+// rollback rolls back the transaction and undoes the in-memory steps in the
+// reverse order. It returns the errors of the undo, the players differ from
+// the database after them.
+func (u *unitOfWork) rollback() error {
+	var errs []error
+	err := u.tx.Rollback()
+	if err != nil && !errors.Is(err, sql.ErrTxDone) {
+		errs = append(errs, err)
+	}
+
+	for i := len(u.undo) - 1; i >= 0; i-- {
+		errs = append(errs, u.undo[i]())
+	}
+	return errors.Join(errs...)
+}
+
+// inTx runs fn as a unit of work and commits it when fn succeeds, then
+// makes the deferred in-memory steps. When fn or the commit fails, the
+// transaction is rolled back and the deletions are undone. The errors of
+// the in-memory steps and of the undo are returned together with the error
+// of the unit of work. The checks address the entries by their places, so
+// no other unit of work runs until this one is done.
+func (c *playlistController) inTx(ctx context.Context, fn func(u *unitOfWork) error) error {
+	c.txMutex.Lock()
+	defer c.txMutex.Unlock()
+
+	tx, err := c.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	u := &unitOfWork{tx: tx}
+	err = fn(u)
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		undoErr := u.rollback()
+		if undoErr != nil {
+			return errors.Join(err, undoErr)
+		}
+		return err
+	}
+
+	var errs []error
+	for _, step := range u.onCommit {
+		errs = append(errs, step())
+	}
+	return errors.Join(errs...)
+}
+
+// addEntry appends the song to the player after the commit.
+func (u *unitOfWork) addEntry(player playlist.IBasePlaybackMusicPlayer, song playlist.Song) {
+	u.afterCommit(func() error { return player.AddLibrarySong(song) })
+}
+
+// insertEntry inserts the song into the player after the commit. It returns
+// the songs of the player as they are after the insert.
+func (u *unitOfWork) insertEntry(player playlist.IBasePlaybackMusicPlayer, song playlist.Song, index int) ([]playlist.Song, error) {
+	songs := player.Songs()
+	if index < 0 || index > len(songs) {
+		return nil, playlist.ErrorNotValidIndex
+	}
+
+	u.afterCommit(func() error { return player.InsertLibrarySong(song, index) })
+	return slices.Insert(songs, index, song), nil
+}
+
+// moveEntry moves the entry of the player after the commit. It returns the
+// songs of the player as they are after the move.
+func (u *unitOfWork) moveEntry(player playlist.IBasePlaybackMusicPlayer, from int, to int) ([]playlist.Song, error) {
+	songs := player.Songs()
+	if from < 0 || from >= len(songs) || to < 0 || to >= len(songs) {
+		return nil, playlist.ErrorNotValidIndex
+	}
+
+	u.afterCommit(func() error { return player.MoveAt(from, to) })
+	song := songs[from]
+	return slices.Insert(slices.Delete(songs, from, from+1), to, song), nil
+}
+
+// swapEntries swaps the first entries of the two songs after the commit. It
+// returns the songs of the player as they are after the swap.
+func (u *unitOfWork) swapEntries(player playlist.IBasePlaybackMusicPlayer, first string, second string) ([]playlist.Song, error) {
+	songs := player.Songs()
+	i, j := indexOf(songs, first), indexOf(songs, second)
+	if i < 0 || j < 0 {
+		return nil, playlist.ErrorNotFoundSong
+	}
+
+	u.afterCommit(func() error { return player.SwapSongs(first, second) })
+	songs[i], songs[j] = songs[j], songs[i]
+	return songs, nil
+}
+
+// deleteEntries deletes every entry of the song from the player.
+func (u *unitOfWork) deleteEntries(player playlist.IBasePlaybackMusicPlayer, id int) error {
+	var indexes []int
+	for i, song := range player.Songs() {
+		if song.ID == id {
+			indexes = append(indexes, i)
+		}
+	}
+	return u.deleteAt(player, id, indexes)
+}
+
+// removeEntry deletes the first entry of the song from the player.
+func (u *unitOfWork) removeEntry(player playlist.IBasePlaybackMusicPlayer, id int) error {
+	for i, song := range player.Songs() {
+		if song.ID == id {
+			return u.deleteAt(player, id, []int{i})
+		}
+	}
+	return playlist.ErrorNotFoundSong
+}
+
+// deleteAt deletes the entries of the song at the increasing indexes, the
+// last one first, so the places of the rest stay the same. The undo puts
+// the deleted entries back at their places and the cursor of the stopped
+// player back on the entry it was on.
+func (u *unitOfWork) deleteAt(player playlist.IBasePlaybackMusicPlayer, id int, indexes []int) error {
+	songs := player.Songs()
+	state := player.State()
+
+	deleted := 0
+	u.compensate(func() error {
+		if deleted == 0 {
+			return nil
+		}
+		for _, i := range indexes[len(indexes)-deleted:] {
+			err := player.InsertLibrarySong(songs[i], i)
+			if err != nil {
+				return err
+			}
+		}
+		return restoreCursor(player, state, id, indexes)
+	})
+
+	for i := len(indexes) - 1; i >= 0; i-- {
+		err := player.DeleteAt(indexes[i])
+		if err != nil {
+			return err
+		}
+		deleted++
+	}
+	return nil
+}
+
+// restoreCursor puts the cursor back on the deleted entry it was on before
+// the delete moved it. A queued copy of the song is put back as its first
+// entry. The player started since then keeps its cursor.
+func restoreCursor(player playlist.IBasePlaybackMusicPlayer, state playlist.PlaybackState, id int, indexes []int) error {
+	if state.Status != playlist.StatusStopped || state.Song == nil || state.Song.ID != id {
+		return nil
+	}
+
+	index := indexes[0]
+	if state.Index >= 0 {
+		if !slices.Contains(indexes, state.Index) {
+			return nil
+		}
+		index = state.Index
+	}
+
+	err := player.RestoreAt(index, state.Elapsed)
+	if errors.Is(err, playlist.ErrorPlayingPlaylist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"MusicPlayerProject/internal/data"
+	"MusicPlayerProject/internal/playlist"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errInjected = errors.New("injected failure")
+
+var (
+	txSong1 = playlist.Song{ID: 1, Title: "Song 1", Duration: time.Minute}
+	txSong2 = playlist.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+	txSong3 = playlist.Song{ID: 3, Title: "Song 3", Duration: time.Minute}
+)
+
+// newTxController builds a controller whose library holds the songs and
+// whose named playlist 1 holds the entries, without going through the
+// database. The failures of a test must be registered before it is called.
+func newTxController(t *testing.T, mockRepo *MockSongDB, songs []playlist.Song, entries []playlist.Song) *playlistController {
+	c := newController(t, mockRepo).(*playlistController)
+	for _, song := range songs {
+		assert.NoError(t, c.playlist.AddLibrarySong(song))
+	}
+
+	c.players[1] = playlist.NewPlaylist()
+	for _, song := range entries {
+		assert.NoError(t, c.players[1].AddLibrarySong(song))
+	}
+
+	mockRepo.On("GetPlaylist", mock.Anything, 1).Return(&data.Playlist{ID: 1, Name: "Playlist 1"}, nil)
+	return c
+}
+
+func titlesOf(player playlist.IBasePlaybackMusicPlayer) []string {
+	var titles []string
+	for _, song := range player.Songs() {
+		titles = append(titles, song.Title)
+	}
+	return titles
+}
+
+func TestCreateSongRollback(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{Title: "Song 1", Duration: time.Minute}
+
+	tests := []struct {
+		name   string
+		inject func(m *MockSongDB)
+	}{
+		{"begin", func(m *MockSongDB) {
+			m.On("BeginTx", ctx).Return(errInjected).Once()
+		}},
+		{"create", func(m *MockSongDB) {
+			m.On("Create", ctx, song).Return(0, errInjected)
+		}},
+		{"commit", func(m *MockSongDB) {
+			m.On("Commit").Return(errInjected).Once()
+		}},
+	}
+
+	for _, tt := range tests {
+		mockRepo := new(MockSongDB)
+		tt.inject(mockRepo)
+		c := newTxController(t, mockRepo, nil, nil)
+
+		mockRepo.On("Find", ctx, "", "Song 1", "").Return((*data.Song)(nil), nil)
+		mockRepo.On("Create", ctx, song).Return(1, nil)
+
+		_, err := c.CreateSong(ctx, song)
+		assert.ErrorIs(t, err, errInjected, "%s: expected the injected error, but got: %v", tt.name, err)
+		assert.Empty(t, c.playlist.Songs(), "%s: expected the song not to be added to the playlist", tt.name)
+	}
+}
+
+func TestDeleteSongRollback(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+
+	tests := []struct {
+		name   string
+		inject func(m *MockSongDB)
+	}{
+		{"delete", func(m *MockSongDB) {
+			m.On("Delete", ctx, "Song 2").Return(errInjected)
+		}},
+		{"commit", func(m *MockSongDB) {
+			m.On("Commit").Return(errInjected).Once()
+		}},
+	}
+
+	for _, tt := range tests {
+		mockRepo := new(MockSongDB)
+		tt.inject(mockRepo)
+		c := newTxController(t, mockRepo,
+			[]playlist.Song{txSong1, txSong2, txSong3},
+			[]playlist.Song{txSong2, txSong1, txSong2})
+
+		mockRepo.On("Get", ctx, "Song 2").Return(song, nil)
+		mockRepo.On("Delete", ctx, "Song 2").Return(nil)
+
+		err := c.DeleteSong(ctx, "Song 2")
+		assert.ErrorIs(t, err, errInjected, "%s: expected the injected error, but got: %v", tt.name, err)
+
+		// the entries are back at their places
+		assert.Equal(t, []string{"Song 1", "Song 2", "Song 3"}, titlesOf(c.playlist), "%s: expected the library to be kept", tt.name)
+		assert.Equal(t, []string{"Song 2", "Song 1", "Song 2"}, titlesOf(c.players[1]), "%s: expected the playlist to be kept", tt.name)
+		mockRepo.AssertCalled(t, "Rollback")
+	}
+}
+
+func TestDeleteSongRollbackCursor(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, []playlist.Song{txSong1, txSong2, txSong3}, nil)
+	mockRepo.On("Get", ctx, "Song 2").Return(song, nil)
+	mockRepo.On("Delete", ctx, "Song 2").Return(nil)
+
+	// the stopped player is on the song, the delete moves it to the next one
+	assert.NoError(t, c.playlist.RestoreAt(1, 30*time.Second))
+
+	err := c.DeleteSong(ctx, "Song 2")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+
+	state := c.playlist.State()
+	assert.Equal(t, "Song 2", state.Song.Title, "expected the cursor to be back on the song")
+	assert.Equal(t, 30*time.Second, state.Elapsed, "expected the position to be kept")
+}
+
+func TestRollbackEvents(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, nil, []playlist.Song{txSong1})
+	mockRepo.On("Get", ctx, "Song 2").Return(song, nil)
+	mockRepo.On("AddPlaylistSong", ctx, 1, 2).Return(nil)
+
+	subscription := c.players[1].Subscribe(watchBufferSize, playlist.DropOldest)
+	defer subscription.Close()
+
+	// the subscribers see the entry only when it is stored
+	err := c.AddSongToPlaylist(ctx, 1, "Song 2")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.Empty(t, subscription.Events(), "expected no events of the rolled back entry")
+
+	err = c.AddSongToPlaylist(ctx, 1, "Song 2")
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	event := <-subscription.Events()
+	assert.Equal(t, playlist.EventSongAdded, event.Type, "expected the event of the stored entry")
+}
+
+func TestUndoError(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{ID: 3, Title: "Song 3", Duration: time.Minute}
+
+	// the library loses a song behind the back of the unit of work, so the
+	// deleted entry cannot be put back at its place
+	var c *playlistController
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Run(func(mock.Arguments) {
+		assert.NoError(t, c.playlist.DeleteAt(0))
+	}).Return(errInjected).Once()
+	c = newTxController(t, mockRepo, []playlist.Song{txSong1, txSong2, txSong3}, nil)
+	mockRepo.On("Get", ctx, "Song 3").Return(song, nil)
+	mockRepo.On("Delete", ctx, "Song 3").Return(nil)
+
+	err := c.DeleteSong(ctx, "Song 3")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.ErrorIs(t, err, playlist.ErrorNotValidIndex, "expected the error of the undo, but got: %v", err)
+}
+
+func TestUpdateSongRollback(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, []playlist.Song{txSong1, txSong2}, []playlist.Song{txSong1})
+
+	mockRepo.On("GetByID", ctx, 1).Return(&data.Song{ID: 1, Title: "Song 1", Duration: time.Minute}, nil)
+	mockRepo.On("Find", ctx, "", "New Title", "").Return((*data.Song)(nil), nil)
+	updated := &data.Song{Title: "New Title", Duration: 2 * time.Minute}
+	mockRepo.On("UpdateByID", ctx, 1, updated).Return(nil)
+
+	err := c.UpdateSongByID(ctx, 1, updated)
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+
+	assert.Equal(t, []string{"Song 1", "Song 2"}, titlesOf(c.playlist), "expected the old title in the library")
+	assert.Equal(t, []string{"Song 1"}, titlesOf(c.players[1]), "expected the old title in the playlist")
+	assert.Equal(t, time.Minute, c.players[1].Songs()[0].Duration, "expected the old duration in the playlist")
+}
+
+func TestReorderRollback(t *testing.T) {
+	ctx := context.Background()
+
+	// the order cannot be stored
+	mockRepo := new(MockSongDB)
+	c := newTxController(t, mockRepo, []playlist.Song{txSong1, txSong2, txSong3}, []playlist.Song{txSong1, txSong2, txSong3})
+	mockRepo.On("SetPlaylistSongs", ctx, 1, mock.Anything).Return(errInjected)
+
+	err := c.MoveSong(ctx, 1, "Song 1", 2)
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 3"}, titlesOf(c.players[1]), "expected the order to be kept")
+
+	err = c.SwapSongs(ctx, 1, "Song 3", "Song 1")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 3"}, titlesOf(c.players[1]), "expected the order to be kept")
+
+	// the check of the in-memory step fails before the database is touched
+	err = c.MoveSong(ctx, 1, "Song 1", 5)
+	assert.ErrorIs(t, err, playlist.ErrorNotValidIndex, "expected ErrorNotValidIndex, but got: %v", err)
+	mockRepo.AssertNumberOfCalls(t, "SetPlaylistSongs", 2)
+	mockRepo.AssertNumberOfCalls(t, "Rollback", 3)
+}
+
+func TestPlaylistSongRollback(t *testing.T) {
+	ctx := context.Background()
+	song := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, nil, []playlist.Song{txSong1, txSong2, txSong2})
+
+	mockRepo.On("Get", ctx, "Song 2").Return(song, nil)
+	mockRepo.On("AddPlaylistSong", ctx, 1, 2).Return(nil)
+	mockRepo.On("RemovePlaylistSong", ctx, 1, 2).Return(errInjected)
+
+	// the commit fails
+	err := c.AddSongToPlaylist(ctx, 1, "Song 2")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 2"}, titlesOf(c.players[1]), "expected the added entry to be removed")
+
+	// the database step fails before the entry is removed
+	err = c.RemoveSongFromPlaylist(ctx, 1, "Song 2")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	assert.Equal(t, []string{"Song 1", "Song 2", "Song 2"}, titlesOf(c.players[1]), "expected the removed entry to be back")
+}
+
+func TestCreatePlaylistRollback(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, nil, nil)
+
+	mockRepo.On("ListPlaylists", ctx).Return([]*data.Playlist{}, nil)
+	mockRepo.On("CreatePlaylist", ctx, "Playlist 2").Return(2, nil)
+
+	_, err := c.CreatePlaylist(ctx, "Playlist 2")
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+
+	_, err = c.player(2)
+	assert.ErrorIs(t, err, ErrorNotFoundPlaylist, "expected no player for the rolled back playlist")
+}
+
+func TestDeletePlaylistRollback(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Return(errInjected).Once()
+	c := newTxController(t, mockRepo, nil, []playlist.Song{txSong1})
+	mockRepo.On("DeletePlaylist", ctx, 1).Return(nil)
+
+	assert.NoError(t, c.PlaySong(ctx, 1))
+	err := c.DeletePlaylist(ctx, 1)
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+
+	// the playlist is kept, so is its playback
+	player, err := c.player(1)
+	assert.NoError(t, err, "expected the player of the playlist to be kept")
+	assert.Equal(t, playlist.StatusPlaying, player.State().Status, "expected the playlist to keep playing")
+
+	err = c.DeletePlaylist(ctx, 1)
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+	assert.Equal(t, playlist.StatusStopped, player.State().Status, "expected the deleted playlist to be stopped")
+}
+
+func TestConcurrentRollback(t *testing.T) {
+	ctx := context.Background()
+	song2 := &data.Song{ID: 2, Title: "Song 2", Duration: time.Minute}
+	song4 := &data.Song{ID: 4, Title: "Song 4", Duration: time.Minute}
+
+	// the first commit waits until the other unit of work has been started, then fails
+	committing := make(chan struct{})
+	release := make(chan struct{})
+	mockRepo := new(MockSongDB)
+	mockRepo.On("Commit").Run(func(mock.Arguments) {
+		close(committing)
+		<-release
+	}).Return(errInjected).Once()
+	c := newTxController(t, mockRepo, nil, []playlist.Song{txSong1, txSong2, txSong3})
+
+	mockRepo.On("Get", ctx, "Song 2").Return(song2, nil)
+	mockRepo.On("Get", ctx, "Song 4").Return(song4, nil)
+	mockRepo.On("RemovePlaylistSong", ctx, 1, 2).Return(nil)
+	mockRepo.On("SetPlaylistSongs", ctx, 1, mock.Anything).Return(nil)
+
+	removed := make(chan error)
+	go func() { removed <- c.RemoveSongFromPlaylist(ctx, 1, "Song 2") }()
+	<-committing
+
+	started := make(chan struct{})
+	inserted := make(chan error)
+	go func() {
+		close(started)
+		inserted <- c.InsertSong(ctx, 1, "Song 4", 0)
+	}()
+	<-started
+	close(release)
+
+	err := <-removed
+	assert.ErrorIs(t, err, errInjected, "expected the injected error, but got: %v", err)
+	err = <-inserted
+	assert.NoError(t, err, "expected no error, but got: %v", err)
+
+	// the undo puts 'Song 2' back before the other unit of work moves the entries
+	assert.Equal(t, []string{"Song 4", "Song 1", "Song 2", "Song 3"}, titlesOf(c.players[1]), "expected the removed entry to be back at its place")
+}
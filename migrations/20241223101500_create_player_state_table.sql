@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE player_state (
+    id INT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    song_id INT REFERENCES songs (id) ON DELETE SET NULL,
+    position BIGINT NOT NULL DEFAULT 0
+);
+
+-- +goose Down
+DROP TABLE player_state;
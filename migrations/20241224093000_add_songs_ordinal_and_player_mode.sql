@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE songs ADD COLUMN ordinal INT;
+UPDATE songs SET ordinal = id;
+ALTER TABLE songs ALTER COLUMN ordinal SET NOT NULL;
+CREATE INDEX songs_ordinal_idx ON songs (ordinal);
+
+ALTER TABLE player_state ADD COLUMN repeat_mode SMALLINT NOT NULL DEFAULT 1;
+ALTER TABLE player_state ADD COLUMN shuffle BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE player_state ADD COLUMN shuffle_seed BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE player_state DROP COLUMN shuffle_seed;
+ALTER TABLE player_state DROP COLUMN shuffle;
+ALTER TABLE player_state DROP COLUMN repeat_mode;
+
+DROP INDEX songs_ordinal_idx;
+ALTER TABLE songs DROP COLUMN ordinal;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE playlists (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE
+);
+
+CREATE TABLE playlist_songs (
+    playlist_id INT NOT NULL REFERENCES playlists (id) ON DELETE CASCADE,
+    song_id INT NOT NULL REFERENCES songs (id) ON DELETE CASCADE,
+    ordinal INT NOT NULL,
+    PRIMARY KEY (playlist_id, song_id)
+);
+
+CREATE INDEX playlist_songs_ordinal_idx ON playlist_songs (playlist_id, ordinal);
+
+-- +goose Down
+DROP TABLE playlist_songs;
+DROP TABLE playlists;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE playlist_songs DROP CONSTRAINT playlist_songs_pkey;
+ALTER TABLE playlist_songs ADD COLUMN id SERIAL PRIMARY KEY;
+
+-- +goose Down
+DELETE FROM playlist_songs a
+USING playlist_songs b
+WHERE a.playlist_id = b.playlist_id AND a.song_id = b.song_id AND a.id > b.id;
+
+ALTER TABLE playlist_songs DROP COLUMN id;
+ALTER TABLE playlist_songs ADD PRIMARY KEY (playlist_id, song_id);
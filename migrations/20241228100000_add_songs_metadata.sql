@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE songs ADD COLUMN artist VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE songs ADD COLUMN album VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE songs ADD COLUMN album_artist VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE songs ADD COLUMN track_number INT NOT NULL DEFAULT 0;
+ALTER TABLE songs ADD COLUMN disc_number INT NOT NULL DEFAULT 0;
+ALTER TABLE songs ADD COLUMN genre VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE songs ADD COLUMN year INT NOT NULL DEFAULT 0;
+ALTER TABLE songs ADD COLUMN tags JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE songs DROP CONSTRAINT songs_title_key;
+ALTER TABLE songs ADD CONSTRAINT songs_artist_title_album_key UNIQUE (artist, title, album);
+CREATE INDEX songs_title_idx ON songs (title);
+
+-- +goose Down
+DROP INDEX songs_title_idx;
+ALTER TABLE songs DROP CONSTRAINT songs_artist_title_album_key;
+ALTER TABLE songs ADD CONSTRAINT songs_title_key UNIQUE (title);
+
+ALTER TABLE songs DROP COLUMN tags;
+ALTER TABLE songs DROP COLUMN year;
+ALTER TABLE songs DROP COLUMN genre;
+ALTER TABLE songs DROP COLUMN disc_number;
+ALTER TABLE songs DROP COLUMN track_number;
+ALTER TABLE songs DROP COLUMN album_artist;
+ALTER TABLE songs DROP COLUMN album;
+ALTER TABLE songs DROP COLUMN artist;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE songs ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- keyset pagination of ListSongs reads these indexes in both directions
+DROP INDEX songs_title_idx;
+CREATE INDEX songs_title_id_idx ON songs (title, id);
+CREATE INDEX songs_duration_id_idx ON songs (duration, id);
+CREATE INDEX songs_created_at_id_idx ON songs (created_at, id);
+-- title prefix filter
+CREATE INDEX songs_title_pattern_idx ON songs (title text_pattern_ops);
+
+-- +goose Down
+DROP INDEX songs_title_pattern_idx;
+DROP INDEX songs_created_at_id_idx;
+DROP INDEX songs_duration_id_idx;
+DROP INDEX songs_title_id_idx;
+CREATE INDEX songs_title_idx ON songs (title);
+
+ALTER TABLE songs DROP COLUMN created_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- the title weighs more than the artist and the artist more than the album,
+-- the simple configuration keeps names as they are, without stemming
+ALTER TABLE songs ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(artist, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(album, '')), 'C')
+) STORED;
+CREATE INDEX songs_search_idx ON songs USING GIN (search);
+
+-- trigram indexes for the fallback search of misspelled words
+CREATE INDEX songs_title_trgm_idx ON songs USING GIN (title gin_trgm_ops);
+CREATE INDEX songs_artist_trgm_idx ON songs USING GIN (artist gin_trgm_ops);
+CREATE INDEX songs_album_trgm_idx ON songs USING GIN (album gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX songs_album_trgm_idx;
+DROP INDEX songs_artist_trgm_idx;
+DROP INDEX songs_title_trgm_idx;
+
+DROP INDEX songs_search_idx;
+ALTER TABLE songs DROP COLUMN search;
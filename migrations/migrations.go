@@ -0,0 +1,26 @@
+// Package migrations embeds the goose migrations of the SQL storages into the
+// binary, so the server migrates its database without the migration files.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed *.sql sqlite/*.sql
+var files embed.FS
+
+// Postgres returns the migrations of the PostgreSQL storage.
+func Postgres() fs.FS {
+	return files
+}
+
+// SQLite returns the migrations of the SQLite storage.
+func SQLite() fs.FS {
+	sqlite, err := fs.Sub(files, "sqlite")
+	if err != nil {
+		// the directory is embedded, fs.Sub fails on an invalid path only
+		panic(err)
+	}
+	return sqlite
+}
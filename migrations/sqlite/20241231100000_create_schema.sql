@@ -0,0 +1,88 @@
+-- +goose Up
+-- the schema of the PostgreSQL migrations up to 20241230100000 in one step
+CREATE TABLE songs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL,
+    duration INTEGER NOT NULL,
+    ordinal INTEGER NOT NULL,
+    artist TEXT NOT NULL DEFAULT '',
+    album TEXT NOT NULL DEFAULT '',
+    album_artist TEXT NOT NULL DEFAULT '',
+    track_number INTEGER NOT NULL DEFAULT 0,
+    disc_number INTEGER NOT NULL DEFAULT 0,
+    genre TEXT NOT NULL DEFAULT '',
+    year INTEGER NOT NULL DEFAULT 0,
+    tags TEXT NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    UNIQUE (artist, title, album)
+);
+
+CREATE INDEX songs_ordinal_idx ON songs (ordinal);
+-- keyset pagination of ListSongs reads these indexes in both directions
+CREATE INDEX songs_title_id_idx ON songs (title, id);
+CREATE INDEX songs_duration_id_idx ON songs (duration, id);
+CREATE INDEX songs_created_at_id_idx ON songs (created_at, id);
+
+CREATE TABLE player_state (
+    id INTEGER PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    song_id INTEGER REFERENCES songs (id) ON DELETE SET NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    repeat_mode INTEGER NOT NULL DEFAULT 1,
+    shuffle BOOLEAN NOT NULL DEFAULT FALSE,
+    shuffle_seed INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE playlists (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE playlist_songs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    playlist_id INTEGER NOT NULL REFERENCES playlists (id) ON DELETE CASCADE,
+    song_id INTEGER NOT NULL REFERENCES songs (id) ON DELETE CASCADE,
+    ordinal INTEGER NOT NULL
+);
+
+CREATE INDEX playlist_songs_ordinal_idx ON playlist_songs (playlist_id, ordinal);
+
+-- the full-text index of the search, the title weighs more than the artist
+-- and the artist more than the album
+CREATE VIRTUAL TABLE songs_search USING fts5(title, artist, album, content='songs', content_rowid='id');
+-- the trigram index for the fallback search of misspelled words
+CREATE VIRTUAL TABLE songs_trigram USING fts5(title, artist, album, content='songs', content_rowid='id', tokenize='trigram');
+
+-- +goose StatementBegin
+CREATE TRIGGER songs_search_insert AFTER INSERT ON songs BEGIN
+    INSERT INTO songs_search (rowid, title, artist, album) VALUES (new.id, new.title, new.artist, new.album);
+    INSERT INTO songs_trigram (rowid, title, artist, album) VALUES (new.id, new.title, new.artist, new.album);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER songs_search_delete AFTER DELETE ON songs BEGIN
+    INSERT INTO songs_search (songs_search, rowid, title, artist, album) VALUES ('delete', old.id, old.title, old.artist, old.album);
+    INSERT INTO songs_trigram (songs_trigram, rowid, title, artist, album) VALUES ('delete', old.id, old.title, old.artist, old.album);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER songs_search_update AFTER UPDATE OF title, artist, album ON songs BEGIN
+    INSERT INTO songs_search (songs_search, rowid, title, artist, album) VALUES ('delete', old.id, old.title, old.artist, old.album);
+    INSERT INTO songs_trigram (songs_trigram, rowid, title, artist, album) VALUES ('delete', old.id, old.title, old.artist, old.album);
+    INSERT INTO songs_search (rowid, title, artist, album) VALUES (new.id, new.title, new.artist, new.album);
+    INSERT INTO songs_trigram (rowid, title, artist, album) VALUES (new.id, new.title, new.artist, new.album);
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER songs_search_update;
+DROP TRIGGER songs_search_delete;
+DROP TRIGGER songs_search_insert;
+DROP TABLE songs_trigram;
+DROP TABLE songs_search;
+
+DROP TABLE playlist_songs;
+DROP TABLE playlists;
+DROP TABLE player_state;
+DROP TABLE songs;
@@ -19,15 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PlaylistService_CreateSong_FullMethodName = "/playlist.PlaylistService/CreateSong"
-	PlaylistService_GetSong_FullMethodName    = "/playlist.PlaylistService/GetSong"
-	PlaylistService_UpdateSong_FullMethodName = "/playlist.PlaylistService/UpdateSong"
-	PlaylistService_DeleteSong_FullMethodName = "/playlist.PlaylistService/DeleteSong"
-	PlaylistService_ListSongs_FullMethodName  = "/playlist.PlaylistService/ListSongs"
-	PlaylistService_Play_FullMethodName       = "/playlist.PlaylistService/Play"
-	PlaylistService_Pause_FullMethodName      = "/playlist.PlaylistService/Pause"
-	PlaylistService_Next_FullMethodName       = "/playlist.PlaylistService/Next"
-	PlaylistService_Prev_FullMethodName       = "/playlist.PlaylistService/Prev"
+	PlaylistService_CreateSong_FullMethodName             = "/playlist.PlaylistService/CreateSong"
+	PlaylistService_GetSong_FullMethodName                = "/playlist.PlaylistService/GetSong"
+	PlaylistService_UpdateSong_FullMethodName             = "/playlist.PlaylistService/UpdateSong"
+	PlaylistService_DeleteSong_FullMethodName             = "/playlist.PlaylistService/DeleteSong"
+	PlaylistService_GetSongByID_FullMethodName            = "/playlist.PlaylistService/GetSongByID"
+	PlaylistService_UpdateSongByID_FullMethodName         = "/playlist.PlaylistService/UpdateSongByID"
+	PlaylistService_DeleteSongByID_FullMethodName         = "/playlist.PlaylistService/DeleteSongByID"
+	PlaylistService_ListSongs_FullMethodName              = "/playlist.PlaylistService/ListSongs"
+	PlaylistService_SearchSongs_FullMethodName            = "/playlist.PlaylistService/SearchSongs"
+	PlaylistService_CreatePlaylist_FullMethodName         = "/playlist.PlaylistService/CreatePlaylist"
+	PlaylistService_ListPlaylists_FullMethodName          = "/playlist.PlaylistService/ListPlaylists"
+	PlaylistService_RenamePlaylist_FullMethodName         = "/playlist.PlaylistService/RenamePlaylist"
+	PlaylistService_DeletePlaylist_FullMethodName         = "/playlist.PlaylistService/DeletePlaylist"
+	PlaylistService_AddSongToPlaylist_FullMethodName      = "/playlist.PlaylistService/AddSongToPlaylist"
+	PlaylistService_RemoveSongFromPlaylist_FullMethodName = "/playlist.PlaylistService/RemoveSongFromPlaylist"
+	PlaylistService_InsertSong_FullMethodName             = "/playlist.PlaylistService/InsertSong"
+	PlaylistService_MoveSong_FullMethodName               = "/playlist.PlaylistService/MoveSong"
+	PlaylistService_SwapSongs_FullMethodName              = "/playlist.PlaylistService/SwapSongs"
+	PlaylistService_EnqueueNext_FullMethodName            = "/playlist.PlaylistService/EnqueueNext"
+	PlaylistService_ListQueue_FullMethodName              = "/playlist.PlaylistService/ListQueue"
+	PlaylistService_ClearQueue_FullMethodName             = "/playlist.PlaylistService/ClearQueue"
+	PlaylistService_Play_FullMethodName                   = "/playlist.PlaylistService/Play"
+	PlaylistService_Pause_FullMethodName                  = "/playlist.PlaylistService/Pause"
+	PlaylistService_Stop_FullMethodName                   = "/playlist.PlaylistService/Stop"
+	PlaylistService_Next_FullMethodName                   = "/playlist.PlaylistService/Next"
+	PlaylistService_Prev_FullMethodName                   = "/playlist.PlaylistService/Prev"
+	PlaylistService_PlayAt_FullMethodName                 = "/playlist.PlaylistService/PlayAt"
+	PlaylistService_Seek_FullMethodName                   = "/playlist.PlaylistService/Seek"
+	PlaylistService_SetShuffle_FullMethodName             = "/playlist.PlaylistService/SetShuffle"
+	PlaylistService_SetRepeatMode_FullMethodName          = "/playlist.PlaylistService/SetRepeatMode"
+	PlaylistService_GetPlaybackState_FullMethodName       = "/playlist.PlaylistService/GetPlaybackState"
+	PlaylistService_WatchPlayback_FullMethodName          = "/playlist.PlaylistService/WatchPlayback"
 )
 
 // PlaylistServiceClient is the client API for PlaylistService service.
@@ -38,11 +61,34 @@ type PlaylistServiceClient interface {
 	GetSong(ctx context.Context, in *GetSongRequest, opts ...grpc.CallOption) (*SongResponse, error)
 	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*SongResponse, error)
 	DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
-	ListSongs(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ListSongsResponse, error)
-	Play(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
-	Pause(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
-	Next(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
-	Prev(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetSongByID(ctx context.Context, in *SongIDRequest, opts ...grpc.CallOption) (*SongResponse, error)
+	UpdateSongByID(ctx context.Context, in *UpdateSongByIDRequest, opts ...grpc.CallOption) (*SongResponse, error)
+	DeleteSongByID(ctx context.Context, in *SongIDRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*PlaylistResponse, error)
+	ListPlaylists(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ListPlaylistsResponse, error)
+	RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*PlaylistResponse, error)
+	DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	AddSongToPlaylist(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	RemoveSongFromPlaylist(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	InsertSong(ctx context.Context, in *InsertSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	MoveSong(ctx context.Context, in *MoveSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	SwapSongs(ctx context.Context, in *SwapSongsRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	EnqueueNext(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	ListQueue(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*ListSongsResponse, error)
+	ClearQueue(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Play(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Pause(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Stop(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Next(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Prev(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	PlayAt(ctx context.Context, in *PlayAtRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	SetShuffle(ctx context.Context, in *SetShuffleRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	SetRepeatMode(ctx context.Context, in *SetRepeatModeRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetPlaybackState(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*PlaybackStateResponse, error)
+	WatchPlayback(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlaybackEvent], error)
 }
 
 type playlistServiceClient struct {
@@ -93,7 +139,37 @@ func (c *playlistServiceClient) DeleteSong(ctx context.Context, in *DeleteSongRe
 	return out, nil
 }
 
-func (c *playlistServiceClient) ListSongs(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+func (c *playlistServiceClient) GetSongByID(ctx context.Context, in *SongIDRequest, opts ...grpc.CallOption) (*SongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetSongByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) UpdateSongByID(ctx context.Context, in *UpdateSongByIDRequest, opts ...grpc.CallOption) (*SongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_UpdateSongByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeleteSongByID(ctx context.Context, in *SongIDRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_DeleteSongByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSongsResponse)
 	err := c.cc.Invoke(ctx, PlaylistService_ListSongs_FullMethodName, in, out, cOpts...)
@@ -103,7 +179,137 @@ func (c *playlistServiceClient) ListSongs(ctx context.Context, in *EmptyMessage,
 	return out, nil
 }
 
-func (c *playlistServiceClient) Play(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+func (c *playlistServiceClient) SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSongsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_SearchSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*PlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_CreatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListPlaylists(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ListPlaylistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlaylistsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListPlaylists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*PlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_RenamePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_DeletePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) AddSongToPlaylist(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_AddSongToPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) RemoveSongFromPlaylist(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_RemoveSongFromPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) InsertSong(ctx context.Context, in *InsertSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_InsertSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) MoveSong(ctx context.Context, in *MoveSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_MoveSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) SwapSongs(ctx context.Context, in *SwapSongsRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_SwapSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) EnqueueNext(ctx context.Context, in *PlaylistSongRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_EnqueueNext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListQueue(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*ListSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSongsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ClearQueue(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_ClearQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) Play(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, PlaylistService_Play_FullMethodName, in, out, cOpts...)
@@ -113,7 +319,7 @@ func (c *playlistServiceClient) Play(ctx context.Context, in *EmptyMessage, opts
 	return out, nil
 }
 
-func (c *playlistServiceClient) Pause(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+func (c *playlistServiceClient) Pause(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, PlaylistService_Pause_FullMethodName, in, out, cOpts...)
@@ -123,7 +329,17 @@ func (c *playlistServiceClient) Pause(ctx context.Context, in *EmptyMessage, opt
 	return out, nil
 }
 
-func (c *playlistServiceClient) Next(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+func (c *playlistServiceClient) Stop(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) Next(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, PlaylistService_Next_FullMethodName, in, out, cOpts...)
@@ -133,7 +349,7 @@ func (c *playlistServiceClient) Next(ctx context.Context, in *EmptyMessage, opts
 	return out, nil
 }
 
-func (c *playlistServiceClient) Prev(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*EmptyMessage, error) {
+func (c *playlistServiceClient) Prev(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, PlaylistService_Prev_FullMethodName, in, out, cOpts...)
@@ -143,6 +359,75 @@ func (c *playlistServiceClient) Prev(ctx context.Context, in *EmptyMessage, opts
 	return out, nil
 }
 
+func (c *playlistServiceClient) PlayAt(ctx context.Context, in *PlayAtRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_PlayAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_Seek_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) SetShuffle(ctx context.Context, in *SetShuffleRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_SetShuffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) SetRepeatMode(ctx context.Context, in *SetRepeatModeRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, PlaylistService_SetRepeatMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) GetPlaybackState(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (*PlaybackStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaybackStateResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_GetPlaybackState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) WatchPlayback(ctx context.Context, in *PlaybackRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlaybackEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PlaylistService_ServiceDesc.Streams[0], PlaylistService_WatchPlayback_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PlaybackRequest, PlaybackEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlaylistService_WatchPlaybackClient = grpc.ServerStreamingClient[PlaybackEvent]
+
 // PlaylistServiceServer is the server API for PlaylistService service.
 // All implementations must embed UnimplementedPlaylistServiceServer
 // for forward compatibility.
@@ -151,11 +436,34 @@ type PlaylistServiceServer interface {
 	GetSong(context.Context, *GetSongRequest) (*SongResponse, error)
 	UpdateSong(context.Context, *UpdateSongRequest) (*SongResponse, error)
 	DeleteSong(context.Context, *DeleteSongRequest) (*EmptyMessage, error)
-	ListSongs(context.Context, *EmptyMessage) (*ListSongsResponse, error)
-	Play(context.Context, *EmptyMessage) (*EmptyMessage, error)
-	Pause(context.Context, *EmptyMessage) (*EmptyMessage, error)
-	Next(context.Context, *EmptyMessage) (*EmptyMessage, error)
-	Prev(context.Context, *EmptyMessage) (*EmptyMessage, error)
+	GetSongByID(context.Context, *SongIDRequest) (*SongResponse, error)
+	UpdateSongByID(context.Context, *UpdateSongByIDRequest) (*SongResponse, error)
+	DeleteSongByID(context.Context, *SongIDRequest) (*EmptyMessage, error)
+	ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error)
+	SearchSongs(context.Context, *SearchSongsRequest) (*ListSongsResponse, error)
+	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*PlaylistResponse, error)
+	ListPlaylists(context.Context, *EmptyMessage) (*ListPlaylistsResponse, error)
+	RenamePlaylist(context.Context, *RenamePlaylistRequest) (*PlaylistResponse, error)
+	DeletePlaylist(context.Context, *DeletePlaylistRequest) (*EmptyMessage, error)
+	AddSongToPlaylist(context.Context, *PlaylistSongRequest) (*EmptyMessage, error)
+	RemoveSongFromPlaylist(context.Context, *PlaylistSongRequest) (*EmptyMessage, error)
+	InsertSong(context.Context, *InsertSongRequest) (*EmptyMessage, error)
+	MoveSong(context.Context, *MoveSongRequest) (*EmptyMessage, error)
+	SwapSongs(context.Context, *SwapSongsRequest) (*EmptyMessage, error)
+	EnqueueNext(context.Context, *PlaylistSongRequest) (*EmptyMessage, error)
+	ListQueue(context.Context, *PlaybackRequest) (*ListSongsResponse, error)
+	ClearQueue(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	Play(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	Pause(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	Stop(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	Next(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	Prev(context.Context, *PlaybackRequest) (*EmptyMessage, error)
+	PlayAt(context.Context, *PlayAtRequest) (*EmptyMessage, error)
+	Seek(context.Context, *SeekRequest) (*EmptyMessage, error)
+	SetShuffle(context.Context, *SetShuffleRequest) (*EmptyMessage, error)
+	SetRepeatMode(context.Context, *SetRepeatModeRequest) (*EmptyMessage, error)
+	GetPlaybackState(context.Context, *PlaybackRequest) (*PlaybackStateResponse, error)
+	WatchPlayback(*PlaybackRequest, grpc.ServerStreamingServer[PlaybackEvent]) error
 	mustEmbedUnimplementedPlaylistServiceServer()
 }
 
@@ -178,21 +486,90 @@ func (UnimplementedPlaylistServiceServer) UpdateSong(context.Context, *UpdateSon
 func (UnimplementedPlaylistServiceServer) DeleteSong(context.Context, *DeleteSongRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
 }
-func (UnimplementedPlaylistServiceServer) ListSongs(context.Context, *EmptyMessage) (*ListSongsResponse, error) {
+func (UnimplementedPlaylistServiceServer) GetSongByID(context.Context, *SongIDRequest) (*SongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSongByID not implemented")
+}
+func (UnimplementedPlaylistServiceServer) UpdateSongByID(context.Context, *UpdateSongByIDRequest) (*SongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSongByID not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeleteSongByID(context.Context, *SongIDRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSongByID not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListSongs(context.Context, *ListSongsRequest) (*ListSongsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSongs not implemented")
 }
-func (UnimplementedPlaylistServiceServer) Play(context.Context, *EmptyMessage) (*EmptyMessage, error) {
+func (UnimplementedPlaylistServiceServer) SearchSongs(context.Context, *SearchSongsRequest) (*ListSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSongs not implemented")
+}
+func (UnimplementedPlaylistServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*PlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListPlaylists(context.Context, *EmptyMessage) (*ListPlaylistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RenamePlaylist(context.Context, *RenamePlaylistRequest) (*PlaylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) DeletePlaylist(context.Context, *DeletePlaylistRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) AddSongToPlaylist(context.Context, *PlaylistSongRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSongToPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) RemoveSongFromPlaylist(context.Context, *PlaylistSongRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSongFromPlaylist not implemented")
+}
+func (UnimplementedPlaylistServiceServer) InsertSong(context.Context, *InsertSongRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSong not implemented")
+}
+func (UnimplementedPlaylistServiceServer) MoveSong(context.Context, *MoveSongRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSong not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SwapSongs(context.Context, *SwapSongsRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSongs not implemented")
+}
+func (UnimplementedPlaylistServiceServer) EnqueueNext(context.Context, *PlaylistSongRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnqueueNext not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListQueue(context.Context, *PlaybackRequest) (*ListSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueue not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ClearQueue(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQueue not implemented")
+}
+func (UnimplementedPlaylistServiceServer) Play(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Play not implemented")
 }
-func (UnimplementedPlaylistServiceServer) Pause(context.Context, *EmptyMessage) (*EmptyMessage, error) {
+func (UnimplementedPlaylistServiceServer) Pause(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
 }
-func (UnimplementedPlaylistServiceServer) Next(context.Context, *EmptyMessage) (*EmptyMessage, error) {
+func (UnimplementedPlaylistServiceServer) Stop(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedPlaylistServiceServer) Next(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Next not implemented")
 }
-func (UnimplementedPlaylistServiceServer) Prev(context.Context, *EmptyMessage) (*EmptyMessage, error) {
+func (UnimplementedPlaylistServiceServer) Prev(context.Context, *PlaybackRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prev not implemented")
 }
+func (UnimplementedPlaylistServiceServer) PlayAt(context.Context, *PlayAtRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlayAt not implemented")
+}
+func (UnimplementedPlaylistServiceServer) Seek(context.Context, *SeekRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seek not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SetShuffle(context.Context, *SetShuffleRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShuffle not implemented")
+}
+func (UnimplementedPlaylistServiceServer) SetRepeatMode(context.Context, *SetRepeatModeRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRepeatMode not implemented")
+}
+func (UnimplementedPlaylistServiceServer) GetPlaybackState(context.Context, *PlaybackRequest) (*PlaybackStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaybackState not implemented")
+}
+func (UnimplementedPlaylistServiceServer) WatchPlayback(*PlaybackRequest, grpc.ServerStreamingServer[PlaybackEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPlayback not implemented")
+}
 func (UnimplementedPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {}
 func (UnimplementedPlaylistServiceServer) testEmbeddedByValue()                         {}
 
@@ -286,98 +663,505 @@ func _PlaylistService_DeleteSong_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+func _PlaylistService_GetSongByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).ListSongs(ctx, in)
+		return srv.(PlaylistServiceServer).GetSongByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_ListSongs_FullMethodName,
+		FullMethod: PlaylistService_GetSongByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).ListSongs(ctx, req.(*EmptyMessage))
+		return srv.(PlaylistServiceServer).GetSongByID(ctx, req.(*SongIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_Play_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+func _PlaylistService_UpdateSongByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSongByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).Play(ctx, in)
+		return srv.(PlaylistServiceServer).UpdateSongByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_Play_FullMethodName,
+		FullMethod: PlaylistService_UpdateSongByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).Play(ctx, req.(*EmptyMessage))
+		return srv.(PlaylistServiceServer).UpdateSongByID(ctx, req.(*UpdateSongByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+func _PlaylistService_DeleteSongByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SongIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).Pause(ctx, in)
+		return srv.(PlaylistServiceServer).DeleteSongByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_Pause_FullMethodName,
+		FullMethod: PlaylistService_DeleteSongByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).Pause(ctx, req.(*EmptyMessage))
+		return srv.(PlaylistServiceServer).DeleteSongByID(ctx, req.(*SongIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_Next_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+func _PlaylistService_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).Next(ctx, in)
+		return srv.(PlaylistServiceServer).ListSongs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_Next_FullMethodName,
+		FullMethod: PlaylistService_ListSongs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).Next(ctx, req.(*EmptyMessage))
+		return srv.(PlaylistServiceServer).ListSongs(ctx, req.(*ListSongsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PlaylistService_Prev_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PlaylistService_SearchSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SearchSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SearchSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SearchSongs(ctx, req.(*SearchSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_CreatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyMessage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PlaylistServiceServer).Prev(ctx, in)
+		return srv.(PlaylistServiceServer).ListPlaylists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PlaylistService_Prev_FullMethodName,
+		FullMethod: PlaylistService_ListPlaylists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PlaylistServiceServer).Prev(ctx, req.(*EmptyMessage))
+		return srv.(PlaylistServiceServer).ListPlaylists(ctx, req.(*EmptyMessage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PlaylistService_ServiceDesc is the grpc.ServiceDesc for PlaylistService service.
-// It's only intended for direct use with grpc.RegisterService,
+func _PlaylistService_RenamePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RenamePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_RenamePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RenamePlaylist(ctx, req.(*RenamePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_DeletePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).DeletePlaylist(ctx, req.(*DeletePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_AddSongToPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).AddSongToPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_AddSongToPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).AddSongToPlaylist(ctx, req.(*PlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_RemoveSongFromPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).RemoveSongFromPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_RemoveSongFromPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).RemoveSongFromPlaylist(ctx, req.(*PlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_InsertSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).InsertSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_InsertSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).InsertSong(ctx, req.(*InsertSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_MoveSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).MoveSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_MoveSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).MoveSong(ctx, req.(*MoveSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SwapSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SwapSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SwapSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SwapSongs(ctx, req.(*SwapSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_EnqueueNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).EnqueueNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_EnqueueNext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).EnqueueNext(ctx, req.(*PlaylistSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListQueue(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ClearQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ClearQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ClearQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ClearQueue(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Play_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Play(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Play_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Play(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Pause(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Stop(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Next_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Next(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Next_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Next(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Prev_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Prev(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Prev_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Prev(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_PlayAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlayAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).PlayAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_PlayAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).PlayAt(ctx, req.(*PlayAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_Seek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeekRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).Seek(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_Seek_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).Seek(ctx, req.(*SeekRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SetShuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SetShuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SetShuffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SetShuffle(ctx, req.(*SetShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_SetRepeatMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepeatModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).SetRepeatMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_SetRepeatMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).SetRepeatMode(ctx, req.(*SetRepeatModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_GetPlaybackState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaybackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).GetPlaybackState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_GetPlaybackState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).GetPlaybackState(ctx, req.(*PlaybackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_WatchPlayback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlaybackRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaylistServiceServer).WatchPlayback(m, &grpc.GenericServerStream[PlaybackRequest, PlaybackEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PlaylistService_WatchPlaybackServer = grpc.ServerStreamingServer[PlaybackEvent]
+
+// PlaylistService_ServiceDesc is the grpc.ServiceDesc for PlaylistService service.
+// It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "playlist.PlaylistService",
@@ -399,10 +1183,74 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSong",
 			Handler:    _PlaylistService_DeleteSong_Handler,
 		},
+		{
+			MethodName: "GetSongByID",
+			Handler:    _PlaylistService_GetSongByID_Handler,
+		},
+		{
+			MethodName: "UpdateSongByID",
+			Handler:    _PlaylistService_UpdateSongByID_Handler,
+		},
+		{
+			MethodName: "DeleteSongByID",
+			Handler:    _PlaylistService_DeleteSongByID_Handler,
+		},
 		{
 			MethodName: "ListSongs",
 			Handler:    _PlaylistService_ListSongs_Handler,
 		},
+		{
+			MethodName: "SearchSongs",
+			Handler:    _PlaylistService_SearchSongs_Handler,
+		},
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _PlaylistService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _PlaylistService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "RenamePlaylist",
+			Handler:    _PlaylistService_RenamePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _PlaylistService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "AddSongToPlaylist",
+			Handler:    _PlaylistService_AddSongToPlaylist_Handler,
+		},
+		{
+			MethodName: "RemoveSongFromPlaylist",
+			Handler:    _PlaylistService_RemoveSongFromPlaylist_Handler,
+		},
+		{
+			MethodName: "InsertSong",
+			Handler:    _PlaylistService_InsertSong_Handler,
+		},
+		{
+			MethodName: "MoveSong",
+			Handler:    _PlaylistService_MoveSong_Handler,
+		},
+		{
+			MethodName: "SwapSongs",
+			Handler:    _PlaylistService_SwapSongs_Handler,
+		},
+		{
+			MethodName: "EnqueueNext",
+			Handler:    _PlaylistService_EnqueueNext_Handler,
+		},
+		{
+			MethodName: "ListQueue",
+			Handler:    _PlaylistService_ListQueue_Handler,
+		},
+		{
+			MethodName: "ClearQueue",
+			Handler:    _PlaylistService_ClearQueue_Handler,
+		},
 		{
 			MethodName: "Play",
 			Handler:    _PlaylistService_Play_Handler,
@@ -411,6 +1259,10 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pause",
 			Handler:    _PlaylistService_Pause_Handler,
 		},
+		{
+			MethodName: "Stop",
+			Handler:    _PlaylistService_Stop_Handler,
+		},
 		{
 			MethodName: "Next",
 			Handler:    _PlaylistService_Next_Handler,
@@ -419,7 +1271,33 @@ var PlaylistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Prev",
 			Handler:    _PlaylistService_Prev_Handler,
 		},
+		{
+			MethodName: "PlayAt",
+			Handler:    _PlaylistService_PlayAt_Handler,
+		},
+		{
+			MethodName: "Seek",
+			Handler:    _PlaylistService_Seek_Handler,
+		},
+		{
+			MethodName: "SetShuffle",
+			Handler:    _PlaylistService_SetShuffle_Handler,
+		},
+		{
+			MethodName: "SetRepeatMode",
+			Handler:    _PlaylistService_SetRepeatMode_Handler,
+		},
+		{
+			MethodName: "GetPlaybackState",
+			Handler:    _PlaylistService_GetPlaybackState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPlayback",
+			Handler:       _PlaylistService_WatchPlayback_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/playlist.proto",
 }